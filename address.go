@@ -0,0 +1,280 @@
+package freee
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Prefecture は JIS X 0401 の都道府県コード(1: 北海道 〜 47: 沖縄県)を表します。
+// freee の prefecture_code は 0 始まり(0: 北海道 〜 46: 沖縄県)のため、相互の変換には
+// PrefectureFromCode と Prefecture.Code を使用します。
+type Prefecture int
+
+var prefectureNames = [...]struct {
+	name string
+	kana string
+}{
+	{"北海道", "ホッカイドウ"},
+	{"青森県", "アオモリケン"},
+	{"岩手県", "イワテケン"},
+	{"宮城県", "ミヤギケン"},
+	{"秋田県", "アキタケン"},
+	{"山形県", "ヤマガタケン"},
+	{"福島県", "フクシマケン"},
+	{"茨城県", "イバラキケン"},
+	{"栃木県", "トチギケン"},
+	{"群馬県", "グンマケン"},
+	{"埼玉県", "サイタマケン"},
+	{"千葉県", "チバケン"},
+	{"東京都", "トウキョウト"},
+	{"神奈川県", "カナガワケン"},
+	{"新潟県", "ニイガタケン"},
+	{"富山県", "トヤマケン"},
+	{"石川県", "イシカワケン"},
+	{"福井県", "フクイケン"},
+	{"山梨県", "ヤマナシケン"},
+	{"長野県", "ナガノケン"},
+	{"岐阜県", "ギフケン"},
+	{"静岡県", "シズオカケン"},
+	{"愛知県", "アイチケン"},
+	{"三重県", "ミエケン"},
+	{"滋賀県", "シガケン"},
+	{"京都府", "キョウトフ"},
+	{"大阪府", "オオサカフ"},
+	{"兵庫県", "ヒョウゴケン"},
+	{"奈良県", "ナラケン"},
+	{"和歌山県", "ワカヤマケン"},
+	{"鳥取県", "トットリケン"},
+	{"島根県", "シマネケン"},
+	{"岡山県", "オカヤマケン"},
+	{"広島県", "ヒロシマケン"},
+	{"山口県", "ヤマグチケン"},
+	{"徳島県", "トクシマケン"},
+	{"香川県", "カガワケン"},
+	{"愛媛県", "エヒメケン"},
+	{"高知県", "コウチケン"},
+	{"福岡県", "フクオカケン"},
+	{"佐賀県", "サガケン"},
+	{"長崎県", "ナガサキケン"},
+	{"熊本県", "クマモトケン"},
+	{"大分県", "オオイタケン"},
+	{"宮崎県", "ミヤザキケン"},
+	{"鹿児島県", "カゴシマケン"},
+	{"沖縄県", "オキナワケン"},
+}
+
+// PrefectureFromCode は freee の prefecture_code から都道府県を返します。
+func PrefectureFromCode(code int) (Prefecture, error) {
+	p := Prefecture(code + 1)
+	if !p.IsValid() {
+		return 0, fmt.Errorf("invalid prefecture code: %d", code)
+	}
+	return p, nil
+}
+
+// PrefectureByName は都道府県名から都道府県を返します。
+// 「東京都」「東京」のように都道府県を省略した名前や、カナ表記も受け付けます。
+func PrefectureByName(name string) (Prefecture, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return 0, false
+	}
+	for i, n := range prefectureNames {
+		if name == n.name || name == n.kana || name == trimPrefectureSuffix(n.name) {
+			return Prefecture(i + 1), true
+		}
+	}
+	return 0, false
+}
+
+// trimPrefectureSuffix は都道府県名の末尾の「都」「府」「県」を取り除きます。(例: 京都府 → 京都)
+func trimPrefectureSuffix(name string) string {
+	for _, suffix := range []string{"都", "府", "県"} {
+		if trimmed, ok := strings.CutSuffix(name, suffix); ok {
+			return trimmed
+		}
+	}
+	return name
+}
+
+// IsValid は JIS X 0401 の都道府県コードの範囲内かどうかを返します。
+func (p Prefecture) IsValid() bool {
+	return p >= 1 && int(p) <= len(prefectureNames)
+}
+
+// Code は freee の prefecture_code を返します。
+func (p Prefecture) Code() int {
+	return int(p) - 1
+}
+
+// Name は漢字の都道府県名を返します。(例: 東京都)
+func (p Prefecture) Name() string {
+	if !p.IsValid() {
+		return ""
+	}
+	return prefectureNames[p-1].name
+}
+
+// Kana はカタカナの都道府県名を返します。(例: トウキョウト)
+func (p Prefecture) Kana() string {
+	if !p.IsValid() {
+		return ""
+	}
+	return prefectureNames[p-1].kana
+}
+
+func (p Prefecture) String() string {
+	return p.Name()
+}
+
+// SplitPrefecture は住所の先頭にある都道府県名と、それ以降の住所に分割します。
+// 先頭が都道府県名でない場合は ok に false を返します。
+func SplitPrefecture(address string) (p Prefecture, rest string, ok bool) {
+	address = strings.TrimSpace(address)
+	for i, n := range prefectureNames {
+		if strings.HasPrefix(address, n.name) {
+			return Prefecture(i + 1), strings.TrimSpace(strings.TrimPrefix(address, n.name)), true
+		}
+	}
+	return 0, address, false
+}
+
+// Zipcode は郵便番号を表します。freee では上3桁と下4桁を zipcode1 と zipcode2 に分けて扱います。
+type Zipcode struct {
+	Zipcode1 string // 上3桁
+	Zipcode2 string // 下4桁
+}
+
+// ParseZipcode は 123-4567 や 1234567 の形式の郵便番号を解析します。
+// 先頭の「〒」や全角の数字・ハイフンも受け付けます。
+func ParseZipcode(s string) (Zipcode, error) {
+	digits := make([]rune, 0, 7)
+	for _, r := range strings.TrimPrefix(strings.TrimSpace(s), "〒") {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, r)
+		case r >= '０' && r <= '９':
+			digits = append(digits, '0'+(r-'０'))
+		case r == '-' || r == '－' || r == 'ー' || r == '‐' || r == ' ':
+		default:
+			return Zipcode{}, fmt.Errorf("invalid zipcode: %q", s)
+		}
+	}
+	if len(digits) != 7 {
+		return Zipcode{}, fmt.Errorf("invalid zipcode: %q", s)
+	}
+	return Zipcode{Zipcode1: string(digits[:3]), Zipcode2: string(digits[3:])}, nil
+}
+
+// IsZero は郵便番号が設定されていないかどうかを返します。
+func (z Zipcode) IsZero() bool {
+	return z.Zipcode1 == "" && z.Zipcode2 == ""
+}
+
+// String は 123-4567 の形式の郵便番号を返します。
+func (z Zipcode) String() string {
+	if z.IsZero() {
+		return ""
+	}
+	return z.Zipcode1 + "-" + z.Zipcode2
+}
+
+// Address は郵便番号・都道府県・それ以降の住所をまとめた住所です。
+type Address struct {
+	Zipcode     Zipcode
+	Prefecture  Prefecture // 設定されていない場合は 0
+	Address     string     // 市区町村以降の住所
+	AddressKana string     // 市区町村以降の住所(カナ)
+}
+
+// ParseAddress は郵便番号と都道府県から始まる住所を分割して Address を作成します。
+func ParseAddress(zipcode string, address string) (Address, error) {
+	z, err := ParseZipcode(zipcode)
+	if err != nil {
+		return Address{}, err
+	}
+	p, rest, ok := SplitPrefecture(address)
+	if !ok {
+		return Address{}, errors.New("address does not start with a prefecture: " + address)
+	}
+	return Address{Zipcode: z, Prefecture: p, Address: rest}, nil
+}
+
+// String は「〒123-4567 東京都千代田区...」の形式の住所を返します。
+func (a Address) String() string {
+	parts := []string{}
+	if !a.Zipcode.IsZero() {
+		parts = append(parts, "〒"+a.Zipcode.String())
+	}
+	parts = append(parts, a.Prefecture.Name()+a.Address)
+	return strings.Join(parts, " ")
+}
+
+// fields は Address を freee の住所の項目に分割します。
+func (a Address) fields() (zipcode1 *string, zipcode2 *string, prefectureCode *int, address *string, addressKana *string) {
+	if !a.Zipcode.IsZero() {
+		zipcode1, zipcode2 = &a.Zipcode.Zipcode1, &a.Zipcode.Zipcode2
+	}
+	if a.Prefecture.IsValid() {
+		code := a.Prefecture.Code()
+		prefectureCode = &code
+	}
+	if a.Address != "" {
+		address = &a.Address
+	}
+	if a.AddressKana != "" {
+		addressKana = &a.AddressKana
+	}
+	return
+}
+
+// newAddress は freee の住所の項目から Address を作成します。
+func newAddress(zipcode1 *string, zipcode2 *string, prefectureCode *int, address *string, addressKana *string) (Address, error) {
+	a := Address{}
+	if zipcode1 != nil {
+		a.Zipcode.Zipcode1 = *zipcode1
+	}
+	if zipcode2 != nil {
+		a.Zipcode.Zipcode2 = *zipcode2
+	}
+	if prefectureCode != nil {
+		p, err := PrefectureFromCode(*prefectureCode)
+		if err != nil {
+			return Address{}, err
+		}
+		a.Prefecture = p
+	}
+	if address != nil {
+		a.Address = *address
+	}
+	if addressKana != nil {
+		a.AddressKana = *addressKana
+	}
+	return a, nil
+}
+
+// FullAddress は従業員の現住所を返します。
+func (r *EmployeeProfileRule) FullAddress() (Address, error) {
+	return newAddress(r.Zipcode1, r.Zipcode2, r.PrefectureCode, r.Address, r.AddressKana)
+}
+
+// SetFullAddress は従業員の現住所の項目を a で置き換えます。
+func (r *EmployeeProfileRule) SetFullAddress(a Address) {
+	r.Zipcode1, r.Zipcode2, r.PrefectureCode, r.Address, r.AddressKana = a.fields()
+}
+
+// FullResidentialAddress は従業員の住民票住所を返します。
+func (r *EmployeeProfileRule) FullResidentialAddress() (Address, error) {
+	return newAddress(r.ResidentialZipcode1, r.ResidentialZipcode2, r.ResidentialPrefectureCode, r.ResidentialAddress, r.ResidentialAddressKana)
+}
+
+// SetFullResidentialAddress は従業員の住民票住所の項目を a で置き換えます。
+func (r *EmployeeProfileRule) SetFullResidentialAddress(a Address) {
+	r.ResidentialZipcode1, r.ResidentialZipcode2, r.ResidentialPrefectureCode, r.ResidentialAddress, r.ResidentialAddressKana = a.fields()
+}
+
+// FullAddress は家族の住所を返します。
+func (r *DependentRule) FullAddress() (Address, error) {
+	return newAddress(r.Zipcode1, r.Zipcode2, r.PrefectureCode, r.Address, r.AddressKana)
+}
@@ -0,0 +1,201 @@
+package freee
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-承認経路
+
+// ApprovalRequestType は承認経路を利用できる申請の種類を表します。
+type ApprovalRequestType string
+
+const (
+	ApprovalRequestTypeOvertimeWork      ApprovalRequestType = "ApprovalRequest::OvertimeWork"      // 残業申請
+	ApprovalRequestTypePaidHoliday       ApprovalRequestType = "ApprovalRequest::PaidHoliday"       // 有給申請
+	ApprovalRequestTypeSpecialHoliday    ApprovalRequestType = "ApprovalRequest::SpecialHoliday"    // 特別休暇申請
+	ApprovalRequestTypeMonthlyAttendance ApprovalRequestType = "ApprovalRequest::MonthlyAttendance" // 月次勤怠締め申請
+	ApprovalRequestTypeWorkTime          ApprovalRequestType = "ApprovalRequest::WorkTime"          // 勤務時間修正申請
+)
+
+// ApprovalFlowStepType は承認ステップで承認者をどのように決めるかを表します。
+type ApprovalFlowStepType string
+
+const (
+	ApprovalFlowStepTypePredefinedUser ApprovalFlowStepType = "predefined_user" // 経路に設定された承認者
+	ApprovalFlowStepTypeSelectedUser   ApprovalFlowStepType = "selected_user"   // 申請時に申請者が選択した承認者
+	ApprovalFlowStepTypeUnspecified    ApprovalFlowStepType = "unspecified"     // 承認者の指定なし(権限を持つユーザー全員)
+)
+
+// ApprovalFlowStepCondition は承認ステップに複数の承認者がいる場合の承認条件を表します。
+type ApprovalFlowStepCondition string
+
+const (
+	ApprovalFlowStepConditionAll ApprovalFlowStepCondition = "all" // 全員の承認が必要
+	ApprovalFlowStepConditionAny ApprovalFlowStepCondition = "any" // RequiredCount 人の承認が必要
+)
+
+type ApprovalFlowRouteApprover struct {
+	ApproverID    *int    `json:"approver_id"`     // 承認者のユーザーID(ユーザー指定の場合)
+	UseCustomRole bool    `json:"use_custom_role"` // true の場合は役職で承認者を指定しています
+	CustomRoleID  *int    `json:"custom_role_id"`  // 承認者の役職ID(役職指定の場合)
+	CustomRole    *string `json:"custom_role"`     // 承認者の役職名(役職指定の場合)
+}
+
+// IsPosition は承認者が役職で指定されているかどうかを返します。
+func (a *ApprovalFlowRouteApprover) IsPosition() bool {
+	return a.UseCustomRole
+}
+
+type ApprovalFlowRouteStep struct {
+	ID            int                         `json:"id"`
+	Type          ApprovalFlowStepType        `json:"type"`
+	NextStepID    *int                        `json:"next_step_id"`
+	Condition     ApprovalFlowStepCondition   `json:"condition"`
+	RequiredCount int                         `json:"required_count"`
+	Approvers     []ApprovalFlowRouteApprover `json:"approvers"`
+}
+
+type ApprovalFlowRoute struct {
+	ID               int                     `json:"id"`
+	CompanyID        int                     `json:"company_id"`
+	Name             string                  `json:"name"`
+	Description      string                  `json:"description"`
+	UserID           *int                    `json:"user_id"`
+	DefinitionSystem bool                    `json:"definition_system"` // システムで作成された経路かどうか
+	FirstStepID      *int                    `json:"first_step_id"`
+	Usages           []ApprovalRequestType   `json:"usages"`
+	Steps            []ApprovalFlowRouteStep `json:"steps"`
+}
+
+// OrderedSteps は FirstStepID から NextStepID をたどった順に承認ステップを返します。
+func (r *ApprovalFlowRoute) OrderedSteps() ([]ApprovalFlowRouteStep, error) {
+	steps := map[int]ApprovalFlowRouteStep{}
+	for _, s := range r.Steps {
+		steps[s.ID] = s
+	}
+
+	ordered := []ApprovalFlowRouteStep{}
+	for id := r.FirstStepID; id != nil; {
+		s, ok := steps[*id]
+		if !ok {
+			return nil, fmt.Errorf("approval flow route %d: step %d not found", r.ID, *id)
+		}
+		if len(ordered) >= len(r.Steps) {
+			return nil, fmt.Errorf("approval flow route %d: steps are cyclic", r.ID)
+		}
+		ordered = append(ordered, s)
+		id = s.NextStepID
+	}
+	return ordered, nil
+}
+
+type ListApprovalFlowRoutesOpts struct {
+	IncludedUserID int                 // 指定したユーザーが申請者として利用できる経路のみを返します。
+	Usage          ApprovalRequestType // 指定した申請の種類で利用できる経路のみを返します。
+}
+
+// ListApprovalFlowRoutes は指定した事業所の承認経路をリストで返します。
+func (c *Client) ListApprovalFlowRoutes(companyID int, opts *ListApprovalFlowRoutesOpts) ([]ApprovalFlowRoute, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_flow_routes"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	if opts != nil {
+		if opts.IncludedUserID > 0 {
+			q.Set("included_user_id", strconv.Itoa(opts.IncludedUserID))
+		}
+		if opts.Usage != "" {
+			q.Set("usage", string(opts.Usage))
+		}
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		ApprovalFlowRoutes []ApprovalFlowRoute `json:"approval_flow_routes"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.ApprovalFlowRoutes, nil
+}
+
+// GetApprovalFlowRoute は指定したIDの承認経路を承認ステップとあわせて返します。
+func (c *Client) GetApprovalFlowRoute(companyID int, approvalFlowRouteID int) (ApprovalFlowRoute, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_flow_routes/" + url.PathEscape(strconv.Itoa(approvalFlowRouteID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return ApprovalFlowRoute{}, err
+	}
+
+	result := struct {
+		ApprovalFlowRoute ApprovalFlowRoute `json:"approval_flow_route"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return ApprovalFlowRoute{}, err
+	}
+
+	return result.ApprovalFlowRoute, nil
+}
+
+type ResolvedApprovalFlowRoute struct {
+	Route ApprovalFlowRoute
+	Steps []ApprovalFlowRouteStep // 承認順に並べた承認ステップ
+	// RequiresApproverSelection は申請時に ApproverID の指定が必要かどうかを表します。
+	RequiresApproverSelection bool
+}
+
+// ResolveApprovalFlowRoute は applicantUserID のユーザーが requestType の申請で使用する承認経路と、承認ステップごとの承認者を返します。
+// 利用できる経路が複数ある場合は、システムで作成された経路よりも事業所で作成された経路を優先し、その中で最もIDの小さい経路を返します。
+func (c *Client) ResolveApprovalFlowRoute(companyID int, requestType ApprovalRequestType, applicantUserID int) (*ResolvedApprovalFlowRoute, error) {
+	routes, err := c.ListApprovalFlowRoutes(companyID, &ListApprovalFlowRoutesOpts{
+		IncludedUserID: applicantUserID,
+		Usage:          requestType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var selected *ApprovalFlowRoute
+	for i := range routes {
+		r := &routes[i]
+		if selected == nil ||
+			(selected.DefinitionSystem && !r.DefinitionSystem) ||
+			(selected.DefinitionSystem == r.DefinitionSystem && r.ID < selected.ID) {
+			selected = r
+		}
+	}
+	if selected == nil {
+		return nil, errors.New("no approval flow route available for " + string(requestType))
+	}
+
+	route, err := c.GetApprovalFlowRoute(companyID, selected.ID)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := route.OrderedSteps()
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := &ResolvedApprovalFlowRoute{
+		Route: route,
+		Steps: steps,
+	}
+	for _, s := range steps {
+		if s.Type == ApprovalFlowStepTypeSelectedUser {
+			resolved.RequiresApproverSelection = true
+		}
+	}
+	return resolved, nil
+}
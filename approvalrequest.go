@@ -0,0 +1,124 @@
+package freee
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-各種申請
+
+// ApprovalRequestStatus は各種申請の申請ステータスを表します。
+type ApprovalRequestStatus string
+
+const (
+	ApprovalRequestStatusDraft      ApprovalRequestStatus = "draft"       // 下書き
+	ApprovalRequestStatusInProgress ApprovalRequestStatus = "in_progress" // 申請中
+	ApprovalRequestStatusApproved   ApprovalRequestStatus = "approved"    // 承認済み
+	ApprovalRequestStatusFeedback   ApprovalRequestStatus = "feedback"    // 差戻し
+)
+
+// ApprovalAction は各種申請に対する承認操作を表します。
+type ApprovalAction string
+
+const (
+	ApprovalActionApprove       ApprovalAction = "approve"        // 承認する
+	ApprovalActionForceApprove  ApprovalAction = "force_approve"  // 代理承認する
+	ApprovalActionCancel        ApprovalAction = "cancel"         // 申請を取り消す(取り下げ)
+	ApprovalActionReject        ApprovalAction = "reject"         // 却下する
+	ApprovalActionFeedback      ApprovalAction = "feedback"       // 申請者へ差し戻す
+	ApprovalActionForceFeedback ApprovalAction = "force_feedback" // 代理で差し戻す
+)
+
+type ApprovalRequestApprover struct {
+	StepID        int     `json:"step_id"`
+	UseCustomRole bool    `json:"use_custom_role"`
+	ApproverID    *int    `json:"approver_id"`
+	ParallelStep  bool    `json:"parallel_step"`
+	ResourceType  string  `json:"resource_type"`
+	IsForceAction bool    `json:"is_force_action"`
+	CustomRoleID  *int    `json:"custom_role_id"`
+	CustomRole    *string `json:"custom_role"`
+}
+
+type ApprovalFlowLog struct {
+	UserID    *int     `json:"user_id"`
+	UpdatedAt DateTime `json:"updated_at"`
+	StepID    *int     `json:"step_id"`
+	Action    string   `json:"action"`
+}
+
+type ListApprovalRequestsOpts struct {
+	Status          ApprovalRequestStatus // 申請ステータス
+	ApplicantID     int                   // 申請者のユーザーID
+	ApproverID      int                   // 承認者のユーザーID
+	StartTargetDate *Date                 // 対象日で絞り込む期間の開始日(YYYY-MM-DD)
+	EndTargetDate   *Date                 // 対象日で絞り込む期間の終了日(YYYY-MM-DD)
+	Limit           int                   // 取得レコードの件数 (デフォルト: 50, 最小: 1, 最大: 100)
+	Offset          int                   // 取得レコードのオフセット (デフォルト: 0)
+}
+
+func (opts *ListApprovalRequestsOpts) values(companyID int) url.Values {
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	if opts != nil {
+		if opts.Status != "" {
+			q.Set("status", string(opts.Status))
+		}
+		if opts.ApplicantID > 0 {
+			q.Set("applicant_id", strconv.Itoa(opts.ApplicantID))
+		}
+		if opts.ApproverID > 0 {
+			q.Set("approver_id", strconv.Itoa(opts.ApproverID))
+		}
+		if opts.StartTargetDate != nil {
+			q.Set("start_target_date", opts.StartTargetDate.String())
+		}
+		if opts.EndTargetDate != nil {
+			q.Set("end_target_date", opts.EndTargetDate.String())
+		}
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset > 0 {
+			q.Set("offset", strconv.Itoa(opts.Offset))
+		}
+	}
+	return q
+}
+
+// ApprovalActionRequest は各種申請の承認操作のリクエストです。
+// TargetRound と TargetStepID には、操作対象の申請の CurrentRound と CurrentStepID を指定します。
+type ApprovalActionRequest struct {
+	CompanyID      int            `json:"company_id"`
+	ApprovalAction ApprovalAction `json:"approval_action"`
+	TargetRound    int            `json:"target_round"`
+	TargetStepID   int            `json:"target_step_id"`
+}
+
+// approvalRequestsPageLimit は申請を全件取得する際の1ページあたりの件数です。
+const approvalRequestsPageLimit = 100
+
+// listAllApprovalRequests は list を offset をずらしながら繰り返し呼び出し、条件に一致する申請をすべて返します。
+// opts の Limit と Offset は無視されます。
+func listAllApprovalRequests[T any](opts *ListApprovalRequestsOpts, list func(*ListApprovalRequestsOpts) ([]T, int, error)) ([]T, error) {
+	o := ListApprovalRequestsOpts{}
+	if opts != nil {
+		o = *opts
+	}
+	o.Limit = approvalRequestsPageLimit
+	o.Offset = 0
+
+	all := []T{}
+	for {
+		items, totalCount, err := list(&o)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) == 0 || len(all) >= totalCount {
+			return all, nil
+		}
+		o.Offset += len(items)
+	}
+}
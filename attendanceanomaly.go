@@ -0,0 +1,222 @@
+package freee
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// AttendanceAnomalyType は勤怠の異常の種類を表します。
+type AttendanceAnomalyType string
+
+const (
+	AttendanceAnomalyMissingClockOut   AttendanceAnomalyType = "missing_clock_out"  // 出勤打刻に対応する退勤打刻がない
+	AttendanceAnomalyInsufficientBreak AttendanceAnomalyType = "insufficient_break" // 労働時間に対して休憩が不足している
+	AttendanceAnomalyLateness          AttendanceAnomalyType = "lateness"           // 遅刻している
+	AttendanceAnomalyEarlyLeaving      AttendanceAnomalyType = "early_leaving"      // 早退している
+	AttendanceAnomalyUnmatchedPunch    AttendanceAnomalyType = "unmatched_punch"    // 勤怠と打刻の出退勤時刻が一致しない
+)
+
+// AttendanceAnomaly は従業員・日付ごとの勤怠の異常です。
+type AttendanceAnomaly struct {
+	EmployeeID int
+	Date       Date
+	Type       AttendanceAnomalyType
+	Detail     string
+}
+
+type DetectAttendanceAnomaliesOpts struct {
+	EmployeeIDs    []int         // 対象の従業員を限定します。(デフォルト: 給与計算対象外を含む事業所の在籍中の全従業員)
+	PunchTolerance time.Duration // 勤怠と打刻の時刻のずれを許容する時間 (デフォルト: 1分)
+}
+
+// breakRequirements は労働時間に対して必要な休憩時間です。(労働基準法第34条)
+var breakRequirements = []struct {
+	workOver time.Duration
+	minBreak time.Duration
+}{
+	{8 * time.Hour, time.Hour},
+	{6 * time.Hour, 45 * time.Minute},
+}
+
+// DetectAttendanceAnomalies は指定した事業所の from から to までの打刻と日次の勤怠を突き合わせ、勤怠の異常を返します。
+// 打刻は ListTimeClocks、日次の勤怠は GetWorkRecordSummaries(WorkRecords: true) から取得します。
+// 出勤から24時間が経過していない勤務中の打刻は、退勤打刻がなくとも異常とはみなしません。
+func (c *Client) DetectAttendanceAnomalies(companyID int, from Date, to Date, opts *DetectAttendanceAnomaliesOpts) ([]AttendanceAnomaly, error) {
+	o := DetectAttendanceAnomaliesOpts{PunchTolerance: time.Minute}
+	if opts != nil {
+		o.EmployeeIDs = opts.EmployeeIDs
+		if opts.PunchTolerance > 0 {
+			o.PunchTolerance = opts.PunchTolerance
+		}
+	}
+
+	employeeIDs := o.EmployeeIDs
+	if len(employeeIDs) == 0 {
+		employees, err := c.ListAllCompaniesEmployees(companyID, &ListAllEmployeesOpts{WithNoPayrollCalculation: true})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range employees {
+			if e.EntryDate > to.String() || (e.RetireDate != nil && *e.RetireDate < from.String()) {
+				continue
+			}
+			employeeIDs = append(employeeIDs, e.ID)
+		}
+	}
+
+	anomalies := []AttendanceAnomaly{}
+	for _, employeeID := range employeeIDs {
+		timeClocks, err := c.listAllTimeClocks(companyID, employeeID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		records, err := c.ListWorkRecords(companyID, employeeID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		found, err := detectAttendanceAnomalies(employeeID, timeClocks, records, o.PunchTolerance, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		anomalies = append(anomalies, found...)
+	}
+	return anomalies, nil
+}
+
+// dailyPunches は打刻日ごとの出勤・退勤の打刻です。
+type dailyPunches struct {
+	clockIn  *time.Time // 最初の出勤打刻
+	clockOut *time.Time // 最後の退勤打刻
+}
+
+func groupPunchesByDate(timeClocks []TimeClock) (map[string]*dailyPunches, error) {
+	days := map[string]*dailyPunches{}
+	for _, tc := range timeClocks {
+		at, err := time.Parse(time.RFC3339, tc.Datetime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time clock %d: %v", tc.ID, err)
+		}
+		d, ok := days[tc.Date]
+		if !ok {
+			d = &dailyPunches{}
+			days[tc.Date] = d
+		}
+		switch tc.Type {
+		case TimeClockTypeClockIn:
+			if d.clockIn == nil || at.Before(*d.clockIn) {
+				d.clockIn = &at
+			}
+		case TimeClockTypeClockOut:
+			if d.clockOut == nil || at.After(*d.clockOut) {
+				d.clockOut = &at
+			}
+		}
+	}
+	return days, nil
+}
+
+// parseWorkRecordTime は WorkRecord の日時の項目を解析します。値がない場合は nil を返します。
+func parseWorkRecordTime(s *string) (*time.Time, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// detectAttendanceAnomalies は1人の従業員の打刻と日次の勤怠を突き合わせ、勤怠の異常を日付順に返します。
+// now は勤務中の打刻を判定するための現在時刻です。
+func detectAttendanceAnomalies(employeeID int, timeClocks []TimeClock, records []WorkRecord, punchTolerance time.Duration, now time.Time) ([]AttendanceAnomaly, error) {
+	punches, err := groupPunchesByDate(timeClocks)
+	if err != nil {
+		return nil, err
+	}
+
+	anomalies := []AttendanceAnomaly{}
+	add := func(date string, typ AttendanceAnomalyType, format string, args ...any) {
+		d, _ := time.Parse("2006-01-02", date)
+		anomalies = append(anomalies, AttendanceAnomaly{EmployeeID: employeeID, Date: Date(d), Type: typ, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	for date, p := range punches {
+		if p.clockIn != nil && p.clockOut == nil && now.Sub(*p.clockIn) >= shiftExpiration {
+			add(date, AttendanceAnomalyMissingClockOut, "clocked in at %s without clock out", p.clockIn.Format("15:04"))
+		}
+	}
+
+	recordDates := map[string]bool{}
+	for _, r := range records {
+		recordDates[r.Date] = true
+
+		clockIn, err := parseWorkRecordTime(r.ClockInAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid work record %s: %v", r.Date, err)
+		}
+		clockOut, err := parseWorkRecordTime(r.ClockOutAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid work record %s: %v", r.Date, err)
+		}
+
+		if clockIn != nil && clockOut != nil {
+			breaks := time.Duration(0)
+			for _, b := range r.BreakRecords {
+				bIn, err1 := time.Parse(time.RFC3339, b.ClockInAt)
+				bOut, err2 := time.Parse(time.RFC3339, b.ClockOutAt)
+				if err1 == nil && err2 == nil && bOut.After(bIn) {
+					breaks += bOut.Sub(bIn)
+				}
+			}
+			worked := clockOut.Sub(*clockIn) - breaks
+			for _, req := range breakRequirements {
+				if worked > req.workOver && breaks < req.minBreak {
+					add(r.Date, AttendanceAnomalyInsufficientBreak, "worked %s with %s break (requires %s over %s)", worked, breaks, req.minBreak, req.workOver)
+					break
+				}
+			}
+		}
+
+		if r.LatenessMins > 0 {
+			add(r.Date, AttendanceAnomalyLateness, "late by %d minutes", r.LatenessMins)
+		}
+		if r.EarlyLeavingMins > 0 {
+			add(r.Date, AttendanceAnomalyEarlyLeaving, "left early by %d minutes", r.EarlyLeavingMins)
+		}
+
+		p := punches[r.Date]
+		switch {
+		case p == nil && clockIn != nil:
+			add(r.Date, AttendanceAnomalyUnmatchedPunch, "work record has clock in %s but no punches", clockIn.Format("15:04"))
+		case p != nil && p.clockIn != nil && clockIn == nil:
+			add(r.Date, AttendanceAnomalyUnmatchedPunch, "punched in at %s but work record has no clock in", p.clockIn.Format("15:04"))
+		case p != nil:
+			if p.clockIn != nil && clockIn != nil && absDuration(p.clockIn.Sub(*clockIn)) > punchTolerance {
+				add(r.Date, AttendanceAnomalyUnmatchedPunch, "clock in punched at %s but recorded as %s", p.clockIn.Format("15:04"), clockIn.Format("15:04"))
+			}
+			if p.clockOut != nil && clockOut != nil && absDuration(p.clockOut.Sub(*clockOut)) > punchTolerance {
+				add(r.Date, AttendanceAnomalyUnmatchedPunch, "clock out punched at %s but recorded as %s", p.clockOut.Format("15:04"), clockOut.Format("15:04"))
+			}
+		}
+	}
+
+	for date, p := range punches {
+		if !recordDates[date] && p.clockIn != nil && p.clockOut != nil {
+			add(date, AttendanceAnomalyUnmatchedPunch, "punched %s - %s but no work record", p.clockIn.Format("15:04"), p.clockOut.Format("15:04"))
+		}
+	}
+
+	sort.SliceStable(anomalies, func(i, j int) bool {
+		return time.Time(anomalies[i].Date).Before(time.Time(anomalies[j].Date))
+	})
+	return anomalies, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
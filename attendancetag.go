@@ -0,0 +1,156 @@
+package freee
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-勤怠タグ
+
+type AttendanceTag struct {
+	ID        int    `json:"id"`
+	CompanyID int    `json:"company_id"`
+	Name      string `json:"name"`
+}
+
+// ListAttendanceTags は指定した事業所の勤怠タグをリストで返します。
+func (c *Client) ListAttendanceTags(companyID int) ([]AttendanceTag, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/attendance_tags"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		AttendanceTags []AttendanceTag `json:"attendance_tags"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.AttendanceTags, nil
+}
+
+type AttendanceTagRequest struct {
+	CompanyID int    `json:"company_id"`
+	Name      string `json:"name"`
+}
+
+// CreateAttendanceTag は勤怠タグを新規作成します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) CreateAttendanceTag(request *AttendanceTagRequest) (AttendanceTag, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/attendance_tags"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return AttendanceTag{}, err
+	}
+
+	result := struct {
+		AttendanceTag AttendanceTag `json:"attendance_tag"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return AttendanceTag{}, err
+	}
+
+	return result.AttendanceTag, nil
+}
+
+// UpdateAttendanceTag は指定した勤怠タグを更新します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) UpdateAttendanceTag(attendanceTagID int, request *AttendanceTagRequest) (AttendanceTag, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/attendance_tags/" + url.PathEscape(strconv.Itoa(attendanceTagID))
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return AttendanceTag{}, err
+	}
+
+	result := struct {
+		AttendanceTag AttendanceTag `json:"attendance_tag"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return AttendanceTag{}, err
+	}
+
+	return result.AttendanceTag, nil
+}
+
+// DeleteAttendanceTag は指定した勤怠タグを削除します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) DeleteAttendanceTag(companyID int, attendanceTagID int) error {
+	u := "https://api.freee.co.jp/hr/api/v1/attendance_tags/" + url.PathEscape(strconv.Itoa(attendanceTagID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodDelete, u, q, nil)
+	if err != nil {
+		return err
+	}
+	resp.Close()
+	return nil
+}
+
+// AttendanceTagCount は勤怠タグごとの回数です。
+// 日次の勤怠では当日の回数、勤怠サマリでは月の合計回数を表します。
+type AttendanceTagCount struct {
+	AttendanceTagID int    `json:"attendance_tag_id"`
+	Name            string `json:"name"`
+	Count           int    `json:"count"`
+}
+
+// GetEmployeeAttendanceTags は指定した従業員・日付の勤怠タグごとの回数を返します。
+func (c *Client) GetEmployeeAttendanceTags(companyID int, employeeID int, date Date) ([]AttendanceTagCount, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/attendance_tags/" + url.PathEscape(date.String())
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		AttendanceTags []AttendanceTagCount `json:"attendance_tags"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.AttendanceTags, nil
+}
+
+type PutEmployeeAttendanceTagsRequest struct {
+	CompanyID      int                            `json:"company_id"`
+	AttendanceTags []PutEmployeeAttendanceTagsTag `json:"attendance_tags"`
+}
+
+type PutEmployeeAttendanceTagsTag struct {
+	AttendanceTagID int `json:"attendance_tag_id"`
+	Count           int `json:"count"`
+}
+
+// PutEmployeeAttendanceTags は指定した従業員・日付の勤怠タグごとの回数を更新します。
+// 注意点
+// - リクエストに含まれない勤怠タグの回数は0になります。
+func (c *Client) PutEmployeeAttendanceTags(employeeID int, date Date, request *PutEmployeeAttendanceTagsRequest) ([]AttendanceTagCount, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/attendance_tags/" + url.PathEscape(date.String())
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		AttendanceTags []AttendanceTagCount `json:"attendance_tags"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.AttendanceTags, nil
+}
@@ -150,7 +150,29 @@ func (c *Client) do(method string, targetURL string, query url.Values, payload a
 	return nil, handleError(&response{resp})
 }
 
+// APIError は freee API が成功以外のステータスコードを返したことを表すエラーです。
+type APIError struct {
+	StatusCode int
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
 func handleError(r *response) error {
+	err := parseError(r)
+	if err == nil {
+		err = errors.New("invalid status code: " + r.Status)
+	}
+	return &APIError{StatusCode: r.StatusCode, Err: err}
+}
+
+func parseError(r *response) error {
 	defer r.Close()
 
 	invalidStatusCode := func() error {
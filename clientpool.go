@@ -0,0 +1,296 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TokenStore はテナントごとのトークンを保存するストアです。
+// ClientPool から複数のゴルーチンで同時に呼び出されます。
+type TokenStore interface {
+	// Load はテナントのトークンを返します。トークンが存在しない場合はエラーを返します。
+	Load(tenant string) (*AccessToken, error)
+	// Save はトークンの更新時に呼び出され、テナントの更新後のトークンを保存します。
+	Save(tenant string, token *AccessToken) error
+}
+
+type ClientPoolOpts struct {
+	HTTPClient *http.Client // すべての Client で共有する HTTP クライアント (デフォルト: http.DefaultClient)
+	// RateLimit はすべての Client を合わせた1秒あたりのリクエスト数の上限です。0 の場合は制限しません。
+	RateLimit float64
+	Burst     int // RateLimit を超えて連続して送信できるリクエスト数 (デフォルト: 1)
+	// IdleTimeout はリクエストを送信していない Client をプールから取り除くまでの時間です。(デフォルト: 30分)
+	IdleTimeout time.Duration
+	// OnRefreshError はトークンの更新に失敗したときに呼び出されます。
+	// テナントに再度の認可を求める通知などに使用します。失敗した Client はプールから取り除かれます。
+	OnRefreshError func(tenant string, err error)
+	Hooks          Hooks // すべての Client に設定するフック
+}
+
+// ClientPool はテナントごとの Client を管理するプールです。
+// Client は初回の Get で TokenStore のトークンから作成し、以降は同じ Client を返すため、トークンの更新がテナントごとに1回にまとまります。
+// 複数のゴルーチンから同時に使用できます。
+type ClientPool struct {
+	clientID     string
+	clientSecret string
+	store        TokenStore
+	opts         ClientPoolOpts
+	limiter      *rateLimiter
+
+	mu      sync.Mutex
+	clients map[string]*pooledClient
+	tenants map[string]*poolTenant
+}
+
+type pooledClient struct {
+	client   *Client
+	lastUsed atomic.Int64 // 最後にリクエストを送信した Unix ナノ秒
+}
+
+// poolTenant はテナントごとの Client の作成に用いる状態です。
+type poolTenant struct {
+	mu sync.Mutex // TokenStore からの読み込みと Client の作成を直列化します
+	// token は Client をプールから取り除いた後も再利用し、次に作成する Client と共有します。
+	// 取り除かれた Client と新しい Client が同じトークンを別々に更新することを防ぎます。
+	token *tokenManager
+}
+
+// NewClientPool は store からトークンを読み込んで Client を作成する ClientPool を返します。
+func NewClientPool(clientID string, clientSecret string, store TokenStore, opts *ClientPoolOpts) *ClientPool {
+	o := ClientPoolOpts{
+		HTTPClient:  http.DefaultClient,
+		Burst:       1,
+		IdleTimeout: 30 * time.Minute,
+	}
+	if opts != nil {
+		if opts.HTTPClient != nil {
+			o.HTTPClient = opts.HTTPClient
+		}
+		o.RateLimit = opts.RateLimit
+		if opts.Burst > 0 {
+			o.Burst = opts.Burst
+		}
+		if opts.IdleTimeout > 0 {
+			o.IdleTimeout = opts.IdleTimeout
+		}
+		o.OnRefreshError = opts.OnRefreshError
+		o.Hooks = opts.Hooks
+	}
+
+	p := &ClientPool{
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		store:        store,
+		opts:         o,
+		clients:      map[string]*pooledClient{},
+		tenants:      map[string]*poolTenant{},
+	}
+	if o.RateLimit > 0 {
+		p.limiter = newRateLimiter(o.RateLimit, o.Burst)
+	}
+	return p
+}
+
+// Get は tenant の Client を返します。プールにない場合は TokenStore のトークンから作成します。
+// TokenStore からの読み込みはテナントごとに行うため、他のテナントの Get を待たせません。
+// 注意点
+// - プールから取り除かれた後も返された Client は使用できます。IdleTimeout で取り除かれた場合は次の Get で作成される Client とトークンを共有します。
+func (p *ClientPool) Get(tenant string) (*Client, error) {
+	if client, ok := p.pooled(tenant, true); ok {
+		return client, nil
+	}
+
+	p.mu.Lock()
+	t, ok := p.tenants[tenant]
+	if !ok {
+		t = &poolTenant{}
+		p.tenants[tenant] = t
+	}
+	p.mu.Unlock()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// 待っている間に他のゴルーチンが作成した場合はその Client を返します
+	if client, ok := p.pooled(tenant, false); ok {
+		return client, nil
+	}
+
+	if t.token == nil {
+		accessToken, err := p.store.Load(tenant)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load token for tenant %s: %w", tenant, err)
+		}
+		if accessToken == nil {
+			return nil, fmt.Errorf("token for tenant %s is nil", tenant)
+		}
+		m := newTokenManager(p.clientID, p.clientSecret, accessToken, p.opts.HTTPClient)
+		m.OnRefreshToken(func(accessToken *AccessToken) error {
+			return p.store.Save(tenant, accessToken)
+		})
+		m.OnRefreshError(func(err error) {
+			p.remove(tenant, t, m)
+			if p.opts.OnRefreshError != nil {
+				p.opts.OnRefreshError(tenant, err)
+			}
+		})
+		t.token = m
+	}
+
+	pc := &pooledClient{}
+	pc.lastUsed.Store(time.Now().UnixNano())
+	opts := []OptFunc{
+		WithHTTPClient(p.opts.HTTPClient),
+		WithHooks(Hooks{BeforeRequest: func(req *http.Request) (*http.Request, error) {
+			pc.lastUsed.Store(time.Now().UnixNano())
+			if p.limiter != nil {
+				if err := p.limiter.wait(req.Context()); err != nil {
+					return nil, err
+				}
+			}
+			return req, nil
+		}}),
+		WithHooks(p.opts.Hooks),
+	}
+	client, err := New(p.clientID, p.clientSecret, t.token.current(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	client.Token = t.token
+	pc.client = client
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// 作成中に Evict された場合はプールに追加しません
+	if p.tenants[tenant] == t {
+		p.clients[tenant] = pc
+	}
+	return client, nil
+}
+
+// pooled はプールにある tenant の Client を返します。evict が true の場合は先に IdleTimeout を過ぎた Client を取り除きます。
+func (p *ClientPool) pooled(tenant string, evict bool) (*Client, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if evict {
+		p.evictIdle(time.Now())
+	}
+	pc, ok := p.clients[tenant]
+	if !ok {
+		return nil, false
+	}
+	pc.lastUsed.Store(time.Now().UnixNano())
+	return pc.client, true
+}
+
+// Evict は tenant の Client をプールから取り除きます。
+// TokenStore のトークンを外部で更新した場合などに、次の Get で TokenStore から読み込み直して Client を作り直すために使用します。
+func (p *ClientPool) Evict(tenant string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clients, tenant)
+	delete(p.tenants, tenant)
+}
+
+// EvictIdle は IdleTimeout の間リクエストを送信していない Client をプールから取り除き、取り除いた数を返します。
+// Get の呼び出し時にも自動的に行われます。
+func (p *ClientPool) EvictIdle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.evictIdle(time.Now())
+}
+
+// evictIdle は Client のみを取り除き、トークンは次に作成する Client のために残します。
+func (p *ClientPool) evictIdle(now time.Time) int {
+	n := 0
+	for tenant, pc := range p.clients {
+		if now.Sub(time.Unix(0, pc.lastUsed.Load())) >= p.opts.IdleTimeout {
+			delete(p.clients, tenant)
+			n++
+		}
+	}
+	return n
+}
+
+// remove はトークン m の更新に失敗した tenant の Client とトークンをプールから取り除きます。
+// 次の Get では TokenStore から読み込み直します。m がすでに置き換えられている場合は何もしません。
+func (p *ClientPool) remove(tenant string, t *poolTenant, m *tokenManager) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.tenants[tenant] != t {
+		return
+	}
+	if pc, ok := p.clients[tenant]; ok && pc.client.Token == m {
+		delete(p.clients, tenant)
+	}
+	delete(p.tenants, tenant)
+}
+
+// Tenants はプールにある Client のテナントを返します。
+func (p *ClientPool) Tenants() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	tenants := make([]string, 0, len(p.clients))
+	for tenant := range p.clients {
+		tenants = append(tenants, tenant)
+	}
+	return tenants
+}
+
+// rateLimiter はトークンバケット方式でリクエストの送信間隔を制限します。
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // 1秒あたりに補充するトークン数
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve はトークンを1つ予約し、トークンが補充されるまで待つ必要のある時間を返します。
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel は予約したトークンを返却します。
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = min(l.burst, l.tokens+1)
+}
+
+// wait はリクエストを送信できるまで待ちます。ctx が終了した場合は予約を取り消してエラーを返します。
+func (l *rateLimiter) wait(ctx context.Context) error {
+	d := l.reserve(time.Now())
+	if d == 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return fmt.Errorf("rate limit wait canceled: %w", ctx.Err())
+	}
+}
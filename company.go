@@ -0,0 +1,552 @@
+package freee
+
+import (
+	"fmt"
+	"time"
+)
+
+// CompanyClient は1つの事業所に限定した Client です。
+// 各メソッドは Client の同名のメソッドに事業所IDを補って呼び出します。
+// リクエストの CompanyID が0の場合は事業所IDを設定し、別の事業所IDが設定されている場合はエラーを返します。
+// リクエストが nil の場合は、そのまま Client のメソッドに渡します。
+type CompanyClient struct {
+	client *Client
+	id     int
+	name   string
+	role   CompanyRole
+}
+
+// Company は指定した事業所に限定した CompanyClient を返します。
+// GetLoginUser でログインユーザーがその事業所に所属していることを確認し、所属していない場合はエラーを返します。
+func (c *Client) Company(companyID int) (*CompanyClient, error) {
+	loginUser, err := c.GetLoginUser()
+	if err != nil {
+		return nil, err
+	}
+	for _, company := range loginUser.Companies {
+		if company.ID == companyID {
+			return &CompanyClient{client: c, id: companyID, name: company.Name, role: company.Role}, nil
+		}
+	}
+	return nil, fmt.Errorf("login user %d does not belong to company %d", loginUser.ID, companyID)
+}
+
+// ID は事業所IDを返します。
+func (cc *CompanyClient) ID() int {
+	return cc.id
+}
+
+// Name は事業所名を返します。
+func (cc *CompanyClient) Name() string {
+	return cc.name
+}
+
+// Role は事業所におけるログインユーザーの権限を返します。
+func (cc *CompanyClient) Role() CompanyRole {
+	return cc.role
+}
+
+// Client は事業所に限定されていない Client を返します。
+func (cc *CompanyClient) Client() *Client {
+	return cc.client
+}
+
+// fill はリクエストの事業所IDを補います。別の事業所IDが設定されている場合はエラーを返します。
+func (cc *CompanyClient) fill(companyID *int) error {
+	if *companyID != 0 && *companyID != cc.id {
+		return fmt.Errorf("request company_id %d does not match company %d", *companyID, cc.id)
+	}
+	*companyID = cc.id
+	return nil
+}
+
+// 従業員
+
+func (cc *CompanyClient) ListCompaniesEmployees(opts *ListAllEmployeesOpts) ([]CompaniesEmployee, error) {
+	return cc.client.ListCompaniesEmployees(cc.id, opts)
+}
+
+func (cc *CompanyClient) ListAllCompaniesEmployees(opts *ListAllEmployeesOpts) ([]CompaniesEmployee, error) {
+	return cc.client.ListAllCompaniesEmployees(cc.id, opts)
+}
+
+func (cc *CompanyClient) ListEmployees(year int, month int, opts *ListEmployeesOpts) (*ListEmployeeResult, error) {
+	return cc.client.ListEmployees(cc.id, year, month, opts)
+}
+
+func (cc *CompanyClient) GetEmployee(employeeID int, year int, month int) (Employee, error) {
+	return cc.client.GetEmployee(cc.id, employeeID, year, month)
+}
+
+func (cc *CompanyClient) CreateEmployee(request *CreateEmployeeRequest) (Employee, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return Employee{}, err
+		}
+	}
+	return cc.client.CreateEmployee(request)
+}
+
+func (cc *CompanyClient) UpdateEmployee(employeeID int, request *UpdateEmployeeRequest) (Employee, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return Employee{}, err
+		}
+	}
+	return cc.client.UpdateEmployee(employeeID, request)
+}
+
+func (cc *CompanyClient) DeleteEmployee(employeeID int) error {
+	return cc.client.DeleteEmployee(cc.id, employeeID)
+}
+
+func (cc *CompanyClient) DiffEmployee(employeeID int, fromYear int, fromMonth int, toYear int, toMonth int) (*EmployeeDiff, error) {
+	return cc.client.DiffEmployee(cc.id, employeeID, fromYear, fromMonth, toYear, toMonth)
+}
+
+func (cc *CompanyClient) NewEmployeeDirectory(opts *EmployeeDirectoryOpts) (*EmployeeDirectory, error) {
+	return cc.client.NewEmployeeDirectory(cc.id, opts)
+}
+
+// 扶養親族・カスタム項目
+
+func (cc *CompanyClient) ListDependentRules(employeeID int, opts *ListDependentRulesOpts) ([]DependentRule, error) {
+	return cc.client.ListDependentRules(cc.id, employeeID, opts)
+}
+
+func (cc *CompanyClient) CreateDependentRule(employeeID int, request *DependentRuleRequest) (DependentRule, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return DependentRule{}, err
+		}
+	}
+	return cc.client.CreateDependentRule(employeeID, request)
+}
+
+func (cc *CompanyClient) UpdateDependentRule(employeeID int, dependentRuleID int, request *DependentRuleRequest) (DependentRule, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return DependentRule{}, err
+		}
+	}
+	return cc.client.UpdateDependentRule(employeeID, dependentRuleID, request)
+}
+
+func (cc *CompanyClient) DeleteDependentRule(employeeID int, dependentRuleID int) error {
+	return cc.client.DeleteDependentRule(cc.id, employeeID, dependentRuleID)
+}
+
+func (cc *CompanyClient) ListCustomFieldGroups() ([]CustomFieldGroup, error) {
+	return cc.client.ListCustomFieldGroups(cc.id)
+}
+
+func (cc *CompanyClient) ListCustomFields(opts *ListCustomFieldsOpts) ([]CustomField, error) {
+	return cc.client.ListCustomFields(cc.id, opts)
+}
+
+func (cc *CompanyClient) ListEmployeeCustomFieldRules(employeeID int) ([]CustomFieldRule, error) {
+	return cc.client.ListEmployeeCustomFieldRules(cc.id, employeeID)
+}
+
+func (cc *CompanyClient) UpdateEmployeeCustomFieldRules(employeeID int, request *UpdateCustomFieldRulesRequest) ([]CustomFieldRule, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+	return cc.client.UpdateEmployeeCustomFieldRules(employeeID, request)
+}
+
+// 打刻
+
+func (cc *CompanyClient) ListTimeClocks(employeeID int, opts *ListTimeClocksOps) ([]TimeClock, error) {
+	return cc.client.ListTimeClocks(cc.id, employeeID, opts)
+}
+
+func (cc *CompanyClient) GetTimeClock(employeeID int, timeClockID int) (TimeClock, error) {
+	return cc.client.GetTimeClock(cc.id, employeeID, timeClockID)
+}
+
+func (cc *CompanyClient) GetAvailableTypes(employeeID int, opts *GetAvailableTypesOpts) (AvailableTypes, error) {
+	return cc.client.GetAvailableTypes(cc.id, employeeID, opts)
+}
+
+func (cc *CompanyClient) CreateTimeClock(employeeID int, request *CreateTimeClockRequest) (TimeClock, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return TimeClock{}, err
+		}
+	}
+	return cc.client.CreateTimeClock(employeeID, request)
+}
+
+func (cc *CompanyClient) CreateTimeClockIdempotent(employeeID int, request *CreateTimeClockRequest, opts *CreateTimeClockIdempotentOpts) (TimeClock, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return TimeClock{}, err
+		}
+	}
+	return cc.client.CreateTimeClockIdempotent(employeeID, request, opts)
+}
+
+func (cc *CompanyClient) GetTimeClockMachine(employeeID int, at time.Time) (*TimeClockMachine, error) {
+	return cc.client.GetTimeClockMachine(cc.id, employeeID, at)
+}
+
+// 勤怠
+
+func (cc *CompanyClient) GetWorkRecord(employeeID int, date Date) (WorkRecord, error) {
+	return cc.client.GetWorkRecord(cc.id, employeeID, date)
+}
+
+func (cc *CompanyClient) PutWorkRecord(employeeID int, date Date, request *PutWorkRecordRequest) (WorkRecord, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return WorkRecord{}, err
+		}
+	}
+	return cc.client.PutWorkRecord(employeeID, date, request)
+}
+
+func (cc *CompanyClient) DeleteWorkRecord(employeeID int, date Date) error {
+	return cc.client.DeleteWorkRecord(cc.id, employeeID, date)
+}
+
+func (cc *CompanyClient) GetWorkRecordSummaries(employeeID int, year int, month int, opts *GetWorkRecordOpts) (WorkRecordSummaries, error) {
+	return cc.client.GetWorkRecordSummaries(cc.id, employeeID, year, month, opts)
+}
+
+func (cc *CompanyClient) PutWorkRecordSummaries(employeeID int, year int, month int, request *PutWorkRecordSummariesRequest) (WorkRecordSummaries, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return WorkRecordSummaries{}, err
+		}
+	}
+	return cc.client.PutWorkRecordSummaries(employeeID, year, month, request)
+}
+
+func (cc *CompanyClient) ListWorkRecords(employeeID int, from Date, to Date) ([]WorkRecord, error) {
+	return cc.client.ListWorkRecords(cc.id, employeeID, from, to)
+}
+
+func (cc *CompanyClient) ReconcileWorkRecords(employeeID int, from Date, to Date, opts *ReconcileWorkRecordsOpts) (*WorkRecordReconcileReport, error) {
+	return cc.client.ReconcileWorkRecords(cc.id, employeeID, from, to, opts)
+}
+
+func (cc *CompanyClient) DetectAttendanceAnomalies(from Date, to Date, opts *DetectAttendanceAnomaliesOpts) ([]AttendanceAnomaly, error) {
+	return cc.client.DetectAttendanceAnomalies(cc.id, from, to, opts)
+}
+
+// ExpandShifts は template から year 年 month 月の勤怠の更新内容を生成します。
+func (cc *CompanyClient) ExpandShifts(template *ShiftTemplate, year int, month time.Month) ([]ShiftDay, error) {
+	return template.Expand(cc.id, year, month)
+}
+
+func (cc *CompanyClient) ApplyShifts(employeeID int, days []ShiftDay, opts *ApplyShiftsOpts) ([]ShiftApplyResult, error) {
+	for _, day := range days {
+		if day.Request == nil {
+			continue
+		}
+		if err := cc.fill(&day.Request.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+	return cc.client.ApplyShifts(employeeID, days, opts)
+}
+
+// 勤怠タグ
+
+func (cc *CompanyClient) ListAttendanceTags() ([]AttendanceTag, error) {
+	return cc.client.ListAttendanceTags(cc.id)
+}
+
+func (cc *CompanyClient) CreateAttendanceTag(request *AttendanceTagRequest) (AttendanceTag, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return AttendanceTag{}, err
+		}
+	}
+	return cc.client.CreateAttendanceTag(request)
+}
+
+func (cc *CompanyClient) UpdateAttendanceTag(attendanceTagID int, request *AttendanceTagRequest) (AttendanceTag, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return AttendanceTag{}, err
+		}
+	}
+	return cc.client.UpdateAttendanceTag(attendanceTagID, request)
+}
+
+func (cc *CompanyClient) DeleteAttendanceTag(attendanceTagID int) error {
+	return cc.client.DeleteAttendanceTag(cc.id, attendanceTagID)
+}
+
+func (cc *CompanyClient) GetEmployeeAttendanceTags(employeeID int, date Date) ([]AttendanceTagCount, error) {
+	return cc.client.GetEmployeeAttendanceTags(cc.id, employeeID, date)
+}
+
+func (cc *CompanyClient) PutEmployeeAttendanceTags(employeeID int, date Date, request *PutEmployeeAttendanceTagsRequest) ([]AttendanceTagCount, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return nil, err
+		}
+	}
+	return cc.client.PutEmployeeAttendanceTags(employeeID, date, request)
+}
+
+// 残業・有給・特別休暇
+
+func (cc *CompanyClient) GetOvertimeMonths(employeeID int, year int, month int, months int) ([]OvertimeMonth, error) {
+	return cc.client.GetOvertimeMonths(cc.id, employeeID, year, month, months)
+}
+
+func (cc *CompanyClient) CheckEmployeeOvertimeCompliance(employeeID int, year int, month int, limits OvertimeLimits) ([]OvertimeFinding, error) {
+	return cc.client.CheckEmployeeOvertimeCompliance(cc.id, employeeID, year, month, limits)
+}
+
+func (cc *CompanyClient) CheckCompanyOvertimeCompliance(year int, month int, limits OvertimeLimits) ([]OvertimeFinding, error) {
+	return cc.client.CheckCompanyOvertimeCompliance(cc.id, year, month, limits)
+}
+
+func (cc *CompanyClient) ListPaidHolidayGrants(employeeID int) ([]PaidHolidayGrant, error) {
+	return cc.client.ListPaidHolidayGrants(cc.id, employeeID)
+}
+
+func (cc *CompanyClient) GetPaidHolidayBalance(employeeID int, opts *GetPaidHolidayBalanceOpts) (PaidHolidayBalance, error) {
+	return cc.client.GetPaidHolidayBalance(cc.id, employeeID, opts)
+}
+
+func (cc *CompanyClient) GetPaidHolidayObligationProgress(employeeID int, asOf Date) (*PaidHolidayObligationProgress, error) {
+	return cc.client.GetPaidHolidayObligationProgress(cc.id, employeeID, asOf)
+}
+
+func (cc *CompanyClient) ListPaidHolidayObligationProgress(asOf Date) ([]PaidHolidayObligationProgress, error) {
+	return cc.client.ListPaidHolidayObligationProgress(cc.id, asOf)
+}
+
+func (cc *CompanyClient) ListSpecialHolidaySettings() ([]SpecialHolidaySetting, error) {
+	return cc.client.ListSpecialHolidaySettings(cc.id)
+}
+
+// 各種申請
+
+func (cc *CompanyClient) ListApprovalFlowRoutes(opts *ListApprovalFlowRoutesOpts) ([]ApprovalFlowRoute, error) {
+	return cc.client.ListApprovalFlowRoutes(cc.id, opts)
+}
+
+func (cc *CompanyClient) GetApprovalFlowRoute(approvalFlowRouteID int) (ApprovalFlowRoute, error) {
+	return cc.client.GetApprovalFlowRoute(cc.id, approvalFlowRouteID)
+}
+
+func (cc *CompanyClient) ResolveApprovalFlowRoute(requestType ApprovalRequestType, applicantUserID int) (*ResolvedApprovalFlowRoute, error) {
+	return cc.client.ResolveApprovalFlowRoute(cc.id, requestType, applicantUserID)
+}
+
+func (cc *CompanyClient) ListOvertimeWorks(opts *ListApprovalRequestsOpts) (*ListOvertimeWorksResult, error) {
+	return cc.client.ListOvertimeWorks(cc.id, opts)
+}
+
+func (cc *CompanyClient) GetOvertimeWork(overtimeWorkID int) (OvertimeWork, error) {
+	return cc.client.GetOvertimeWork(cc.id, overtimeWorkID)
+}
+
+func (cc *CompanyClient) CreateOvertimeWork(request *OvertimeWorkRequest) (OvertimeWork, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return OvertimeWork{}, err
+		}
+	}
+	return cc.client.CreateOvertimeWork(request)
+}
+
+func (cc *CompanyClient) UpdateOvertimeWork(overtimeWorkID int, request *OvertimeWorkRequest) (OvertimeWork, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return OvertimeWork{}, err
+		}
+	}
+	return cc.client.UpdateOvertimeWork(overtimeWorkID, request)
+}
+
+func (cc *CompanyClient) DeleteOvertimeWork(overtimeWorkID int) error {
+	return cc.client.DeleteOvertimeWork(cc.id, overtimeWorkID)
+}
+
+func (cc *CompanyClient) ActOnOvertimeWork(overtimeWorkID int, request *ApprovalActionRequest) (OvertimeWork, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return OvertimeWork{}, err
+		}
+	}
+	return cc.client.ActOnOvertimeWork(overtimeWorkID, request)
+}
+
+func (cc *CompanyClient) ListPaidHolidays(opts *ListApprovalRequestsOpts) (*ListPaidHolidaysResult, error) {
+	return cc.client.ListPaidHolidays(cc.id, opts)
+}
+
+func (cc *CompanyClient) ListAllPaidHolidays(opts *ListApprovalRequestsOpts) ([]PaidHoliday, error) {
+	return cc.client.ListAllPaidHolidays(cc.id, opts)
+}
+
+func (cc *CompanyClient) GetPaidHoliday(paidHolidayID int) (PaidHoliday, error) {
+	return cc.client.GetPaidHoliday(cc.id, paidHolidayID)
+}
+
+func (cc *CompanyClient) CreatePaidHoliday(request *PaidHolidayRequest) (PaidHoliday, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return PaidHoliday{}, err
+		}
+	}
+	return cc.client.CreatePaidHoliday(request)
+}
+
+func (cc *CompanyClient) ActOnPaidHoliday(paidHolidayID int, request *ApprovalActionRequest) (PaidHoliday, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return PaidHoliday{}, err
+		}
+	}
+	return cc.client.ActOnPaidHoliday(paidHolidayID, request)
+}
+
+func (cc *CompanyClient) ListSpecialHolidays(opts *ListApprovalRequestsOpts) (*ListSpecialHolidaysResult, error) {
+	return cc.client.ListSpecialHolidays(cc.id, opts)
+}
+
+func (cc *CompanyClient) ListAllSpecialHolidays(opts *ListApprovalRequestsOpts) ([]SpecialHoliday, error) {
+	return cc.client.ListAllSpecialHolidays(cc.id, opts)
+}
+
+func (cc *CompanyClient) GetSpecialHoliday(specialHolidayID int) (SpecialHoliday, error) {
+	return cc.client.GetSpecialHoliday(cc.id, specialHolidayID)
+}
+
+func (cc *CompanyClient) GetSpecialHolidayUsedDays(applicantID int, settingID int, from Date, to Date, dayMins int) (float32, error) {
+	return cc.client.GetSpecialHolidayUsedDays(cc.id, applicantID, settingID, from, to, dayMins)
+}
+
+func (cc *CompanyClient) CreateSpecialHoliday(request *SpecialHolidayRequest) (SpecialHoliday, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return SpecialHoliday{}, err
+		}
+	}
+	return cc.client.CreateSpecialHoliday(request)
+}
+
+func (cc *CompanyClient) ActOnSpecialHoliday(specialHolidayID int, request *ApprovalActionRequest) (SpecialHoliday, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return SpecialHoliday{}, err
+		}
+	}
+	return cc.client.ActOnSpecialHoliday(specialHolidayID, request)
+}
+
+func (cc *CompanyClient) ListMonthlyAttendances(opts *ListApprovalRequestsOpts) (*ListMonthlyAttendancesResult, error) {
+	return cc.client.ListMonthlyAttendances(cc.id, opts)
+}
+
+func (cc *CompanyClient) ListAllMonthlyAttendances(opts *ListApprovalRequestsOpts) ([]MonthlyAttendance, error) {
+	return cc.client.ListAllMonthlyAttendances(cc.id, opts)
+}
+
+func (cc *CompanyClient) GetMonthlyAttendance(monthlyAttendanceID int) (MonthlyAttendance, error) {
+	return cc.client.GetMonthlyAttendance(cc.id, monthlyAttendanceID)
+}
+
+func (cc *CompanyClient) CreateMonthlyAttendance(request *MonthlyAttendanceRequest) (MonthlyAttendance, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return MonthlyAttendance{}, err
+		}
+	}
+	return cc.client.CreateMonthlyAttendance(request)
+}
+
+func (cc *CompanyClient) ActOnMonthlyAttendance(monthlyAttendanceID int, request *ApprovalActionRequest) (MonthlyAttendance, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return MonthlyAttendance{}, err
+		}
+	}
+	return cc.client.ActOnMonthlyAttendance(monthlyAttendanceID, request)
+}
+
+func (cc *CompanyClient) GetMonthlyAttendanceStatuses(year int, month int) ([]MonthlyAttendanceStatus, error) {
+	return cc.client.GetMonthlyAttendanceStatuses(cc.id, year, month)
+}
+
+func (cc *CompanyClient) ListWorkTimes(opts *ListApprovalRequestsOpts) (*ListWorkTimesResult, error) {
+	return cc.client.ListWorkTimes(cc.id, opts)
+}
+
+func (cc *CompanyClient) GetWorkTime(workTimeID int) (WorkTime, error) {
+	return cc.client.GetWorkTime(cc.id, workTimeID)
+}
+
+func (cc *CompanyClient) CreateWorkTime(request *WorkTimeRequest) (WorkTime, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return WorkTime{}, err
+		}
+	}
+	return cc.client.CreateWorkTime(request)
+}
+
+func (cc *CompanyClient) ActOnWorkTime(workTimeID int, request *ApprovalActionRequest) (WorkTime, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return WorkTime{}, err
+		}
+	}
+	return cc.client.ActOnWorkTime(workTimeID, request)
+}
+
+// 年末調整
+
+func (cc *CompanyClient) GetYearendAdjustment(employeeID int, year int) (YearendAdjustment, error) {
+	return cc.client.GetYearendAdjustment(cc.id, employeeID, year)
+}
+
+func (cc *CompanyClient) ListYearendAdjustments(year int, opts *ListYearendAdjustmentsOpts) (*ListYearendAdjustmentsResult, error) {
+	return cc.client.ListYearendAdjustments(cc.id, year, opts)
+}
+
+func (cc *CompanyClient) ListAllYearendAdjustments(year int) ([]YearendAdjustment, error) {
+	return cc.client.ListAllYearendAdjustments(cc.id, year)
+}
+
+func (cc *CompanyClient) GetEmployeeYearendAdjustment(employeeID int, year int) (Employee, YearendAdjustment, error) {
+	return cc.client.GetEmployeeYearendAdjustment(cc.id, employeeID, year)
+}
+
+func (cc *CompanyClient) UpdateYearendAdjustmentInsurance(employeeID int, year int, insuranceID int, request *UpdateYearendAdjustmentInsuranceRequest) (YearendAdjustmentInsurance, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return YearendAdjustmentInsurance{}, err
+		}
+	}
+	return cc.client.UpdateYearendAdjustmentInsurance(employeeID, year, insuranceID, request)
+}
+
+func (cc *CompanyClient) UpdateYearendAdjustmentHousingLoan(employeeID int, year int, housingLoanID int, request *UpdateYearendAdjustmentHousingLoanRequest) (YearendAdjustmentHousingLoan, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return YearendAdjustmentHousingLoan{}, err
+		}
+	}
+	return cc.client.UpdateYearendAdjustmentHousingLoan(employeeID, year, housingLoanID, request)
+}
+
+func (cc *CompanyClient) UpdateYearendAdjustmentPreviousJob(employeeID int, year int, previousJobID int, request *UpdateYearendAdjustmentPreviousJobRequest) (YearendAdjustmentPreviousJob, error) {
+	if request != nil {
+		if err := cc.fill(&request.CompanyID); err != nil {
+			return YearendAdjustmentPreviousJob{}, err
+		}
+	}
+	return cc.client.UpdateYearendAdjustmentPreviousJob(employeeID, year, previousJobID, request)
+}
@@ -0,0 +1,273 @@
+package freee
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-カスタム項目
+
+// CustomFieldType はカスタム項目の種別を表します。
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText   CustomFieldType = "text"
+	CustomFieldTypeNumber CustomFieldType = "number"
+	CustomFieldTypeDate   CustomFieldType = "date"
+	CustomFieldTypeSelect CustomFieldType = "select"
+	CustomFieldTypeFile   CustomFieldType = "file"
+)
+
+type CustomFieldGroup struct {
+	ID        int    `json:"id"`
+	CompanyID int    `json:"company_id"`
+	Name      string `json:"name"`
+}
+
+type CustomFieldOption struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type CustomField struct {
+	ID        int                 `json:"id"`
+	CompanyID int                 `json:"company_id"`
+	GroupID   int                 `json:"group_id"`
+	Name      string              `json:"name"`
+	FieldType CustomFieldType     `json:"field_type"`
+	Required  bool                `json:"required"`
+	Options   []CustomFieldOption `json:"options"`
+}
+
+// ListCustomFieldGroups は指定した事業所のカスタム項目グループをリストで返します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) ListCustomFieldGroups(companyID int) ([]CustomFieldGroup, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/custom_field_groups"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		CustomFieldGroups []CustomFieldGroup `json:"custom_field_groups"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.CustomFieldGroups, nil
+}
+
+type ListCustomFieldsOpts struct {
+	GroupID int // 指定したカスタム項目グループに属するカスタム項目のみを返します。
+}
+
+// ListCustomFields は指定した事業所のカスタム項目の定義をリストで返します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) ListCustomFields(companyID int, opts *ListCustomFieldsOpts) ([]CustomField, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/custom_fields"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	if opts != nil {
+		if opts.GroupID > 0 {
+			q.Set("group_id", strconv.Itoa(opts.GroupID))
+		}
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		CustomFields []CustomField `json:"custom_fields"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.CustomFields, nil
+}
+
+type CustomFieldFile struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// CustomFieldRule は従業員に設定されたカスタム項目の値です。
+// Value の内容は FieldType によって異なるため、型ごとのアクセサを使用して取り出します。
+type CustomFieldRule struct {
+	CustomFieldID int             `json:"custom_field_id"`
+	GroupID       int             `json:"group_id"`
+	Name          string          `json:"name"`
+	FieldType     CustomFieldType `json:"field_type"`
+	Value         json.RawMessage `json:"value"`
+}
+
+// IsNull は値が設定されていないかどうかを返します。
+func (r *CustomFieldRule) IsNull() bool {
+	return len(r.Value) == 0 || string(r.Value) == "null"
+}
+
+func (r *CustomFieldRule) decode(fieldType CustomFieldType, v any) error {
+	if r.FieldType != fieldType {
+		return fmt.Errorf("custom field %d is %s, not %s", r.CustomFieldID, r.FieldType, fieldType)
+	}
+	if r.IsNull() {
+		return fmt.Errorf("custom field %d has no value", r.CustomFieldID)
+	}
+	if err := json.Unmarshal(r.Value, v); err != nil {
+		return fmt.Errorf("invalid custom field value: %v", err)
+	}
+	return nil
+}
+
+// Text はテキスト型のカスタム項目の値を返します。
+func (r *CustomFieldRule) Text() (string, error) {
+	var s string
+	if err := r.decode(CustomFieldTypeText, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// Number は数値型のカスタム項目の値を返します。
+func (r *CustomFieldRule) Number() (float64, error) {
+	var n json.Number
+	if err := r.decode(CustomFieldTypeNumber, &n); err != nil {
+		return 0, err
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid custom field value: %v", err)
+	}
+	return f, nil
+}
+
+// Date は日付型のカスタム項目の値を返します。
+func (r *CustomFieldRule) Date() (Date, error) {
+	var s string
+	if err := r.decode(CustomFieldTypeDate, &s); err != nil {
+		return Date{}, err
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid custom field value: %v", err)
+	}
+	return Date(t), nil
+}
+
+// Select は選択型のカスタム項目で選択されている選択肢を返します。
+func (r *CustomFieldRule) Select() (CustomFieldOption, error) {
+	var o CustomFieldOption
+	if err := r.decode(CustomFieldTypeSelect, &o); err != nil {
+		return CustomFieldOption{}, err
+	}
+	return o, nil
+}
+
+// File はファイル型のカスタム項目に添付されているファイルを返します。
+func (r *CustomFieldRule) File() (CustomFieldFile, error) {
+	var f CustomFieldFile
+	if err := r.decode(CustomFieldTypeFile, &f); err != nil {
+		return CustomFieldFile{}, err
+	}
+	return f, nil
+}
+
+// ListEmployeeCustomFieldRules は指定した従業員のカスタム項目の値をリストで返します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) ListEmployeeCustomFieldRules(companyID int, employeeID int) ([]CustomFieldRule, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/custom_field_rules"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		CustomFieldRules []CustomFieldRule `json:"custom_field_rules"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.CustomFieldRules, nil
+}
+
+type UpdateCustomFieldRulesRequest struct {
+	CompanyID        int                     `json:"company_id"`
+	CustomFieldRules []CustomFieldRuleParams `json:"custom_field_rules"`
+}
+
+// CustomFieldRuleParams は更新するカスタム項目の値です。
+// NewTextCustomFieldRuleParams などの型ごとのコンストラクタで作成します。
+// Value に nil を指定すると値を削除します。
+type CustomFieldRuleParams struct {
+	CustomFieldID int `json:"custom_field_id"`
+	Value         any `json:"value"`
+}
+
+func NewTextCustomFieldRuleParams(customFieldID int, value string) CustomFieldRuleParams {
+	return CustomFieldRuleParams{CustomFieldID: customFieldID, Value: value}
+}
+
+func NewNumberCustomFieldRuleParams(customFieldID int, value float64) CustomFieldRuleParams {
+	return CustomFieldRuleParams{CustomFieldID: customFieldID, Value: value}
+}
+
+func NewDateCustomFieldRuleParams(customFieldID int, value Date) CustomFieldRuleParams {
+	return CustomFieldRuleParams{CustomFieldID: customFieldID, Value: value.String()}
+}
+
+// NewSelectCustomFieldRuleParams は選択型のカスタム項目に optionID の選択肢を設定します。
+func NewSelectCustomFieldRuleParams(customFieldID int, optionID int) CustomFieldRuleParams {
+	return CustomFieldRuleParams{CustomFieldID: customFieldID, Value: optionID}
+}
+
+// NewFileCustomFieldRuleParams はファイル型のカスタム項目に fileID のファイルを設定します。
+func NewFileCustomFieldRuleParams(customFieldID int, fileID int) CustomFieldRuleParams {
+	return CustomFieldRuleParams{CustomFieldID: customFieldID, Value: fileID}
+}
+
+// UpdateEmployeeCustomFieldRules は指定した従業員のカスタム項目の値を更新します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+// - リクエストに含まれるカスタム項目のみ更新されます。
+func (c *Client) UpdateEmployeeCustomFieldRules(employeeID int, request *UpdateCustomFieldRulesRequest) ([]CustomFieldRule, error) {
+	if request == nil {
+		return nil, errors.New("request is nil")
+	}
+	if len(request.CustomFieldRules) == 0 {
+		return nil, errors.New("custom_field_rules is empty")
+	}
+
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/custom_field_rules"
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		CustomFieldRules []CustomFieldRule `json:"custom_field_rules"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.CustomFieldRules, nil
+}
@@ -2,6 +2,7 @@ package freee
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -9,6 +10,27 @@ type DateTime time.Time
 type Date time.Time
 type Time time.Time
 
+// parseJSONTime は JSON 文字列を layouts のいずれかで解析します。
+// null または空文字列の場合はゼロ値を返します。
+func parseJSONTime(b []byte, layouts ...string) (time.Time, error) {
+	if string(b) == "null" {
+		return time.Time{}, nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return time.Time{}, err
+	}
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time format: %q", s)
+}
+
 func NewDateTime(year int, month time.Month, day int, hour int, min int, sec int) *DateTime {
 	d := DateTime(time.Date(year, month, day, hour, min, sec, 0, time.UTC))
 	return &d
@@ -23,6 +45,15 @@ func (d *DateTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s)
 }
 
+func (d *DateTime) UnmarshalJSON(b []byte) error {
+	t, err := parseJSONTime(b, time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02T15:04")
+	if err != nil {
+		return err
+	}
+	*d = DateTime(t)
+	return nil
+}
+
 func (d *DateTime) String() string {
 	s := ""
 	if d != nil {
@@ -46,6 +77,15 @@ func (d *Date) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s)
 }
 
+func (d *Date) UnmarshalJSON(b []byte) error {
+	t, err := parseJSONTime(b, "2006-01-02")
+	if err != nil {
+		return err
+	}
+	*d = Date(t)
+	return nil
+}
+
 func (d *Date) String() string {
 	s := ""
 	if d != nil {
@@ -60,6 +100,12 @@ func NewTime(hour int, min int, sec int) *DateTime {
 	return &d
 }
 
+// NewClockTime は時刻(HH:MM:SS)のみを表す Time を返します。
+func NewClockTime(hour int, min int, sec int) *Time {
+	t := Time(time.Date(0, 0, 0, hour, min, sec, 0, time.UTC))
+	return &t
+}
+
 func (t *Time) MarshalJSON() ([]byte, error) {
 	s := ""
 	if t != nil {
@@ -69,6 +115,15 @@ func (t *Time) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s)
 }
 
+func (t *Time) UnmarshalJSON(b []byte) error {
+	u, err := parseJSONTime(b, "15:04:05", "15:04")
+	if err != nil {
+		return err
+	}
+	*t = Time(u)
+	return nil
+}
+
 func (d *Time) String() string {
 	s := ""
 	if d != nil {
@@ -0,0 +1,327 @@
+package freee
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-家族情報
+
+// DependentRelationship は家族の続柄を表します。
+// 一覧にない続柄もそのまま扱うことができます。
+type DependentRelationship string
+
+const (
+	DependentRelationshipHusband        DependentRelationship = "夫"
+	DependentRelationshipWife           DependentRelationship = "妻"
+	DependentRelationshipChild          DependentRelationship = "子"
+	DependentRelationshipFather         DependentRelationship = "父"
+	DependentRelationshipMother         DependentRelationship = "母"
+	DependentRelationshipGrandfather    DependentRelationship = "祖父"
+	DependentRelationshipGrandmother    DependentRelationship = "祖母"
+	DependentRelationshipElderBrother   DependentRelationship = "兄"
+	DependentRelationshipElderSister    DependentRelationship = "姉"
+	DependentRelationshipYoungerBrother DependentRelationship = "弟"
+	DependentRelationshipYoungerSister  DependentRelationship = "妹"
+	DependentRelationshipGrandchild     DependentRelationship = "孫"
+	DependentRelationshipOther          DependentRelationship = "その他"
+)
+
+// IsSpouse は続柄が配偶者かどうかを返します。
+func (r DependentRelationship) IsSpouse() bool {
+	return r == DependentRelationshipHusband || r == DependentRelationshipWife
+}
+
+// DependentType は社会保険・税法上の扶養区分を表します。
+type DependentType string
+
+const (
+	DependentTypeSocialInsuranceAndTax DependentType = "social_insurance_and_tax" // 社会保険・税の扶養
+	DependentTypeSocialInsurance       DependentType = "social_insurance"         // 社会保険の扶養のみ
+	DependentTypeTax                   DependentType = "tax"                      // 税の扶養のみ
+	DependentTypeNone                  DependentType = "none"                     // 扶養しない
+)
+
+// IncludesSocialInsurance は社会保険上の扶養に該当するかどうかを返します。
+func (t DependentType) IncludesSocialInsurance() bool {
+	return t == DependentTypeSocialInsuranceAndTax || t == DependentTypeSocialInsurance
+}
+
+// IncludesTax は税法上の扶養に該当するかどうかを返します。
+func (t DependentType) IncludesTax() bool {
+	return t == DependentTypeSocialInsuranceAndTax || t == DependentTypeTax
+}
+
+// DependentResidenceType は家族の同居・別居の区分を表します。
+type DependentResidenceType string
+
+const (
+	DependentResidenceTypeLivingTogether DependentResidenceType = "living_together" // 同居
+	DependentResidenceTypeSeparated      DependentResidenceType = "separated"       // 別居(国内)
+	DependentResidenceTypeNonResident    DependentResidenceType = "non_resident"    // 別居(国外)
+)
+
+// DependentDisabilityType は家族の障害者区分を表します。
+type DependentDisabilityType string
+
+const (
+	DependentDisabilityTypeNone                  DependentDisabilityType = "none"                    // 障害なし
+	DependentDisabilityTypeOrdinary              DependentDisabilityType = "ordinary"                // 一般障害者
+	DependentDisabilityTypeSpecial               DependentDisabilityType = "special"                 // 特別障害者
+	DependentDisabilityTypeLivingTogetherSpecial DependentDisabilityType = "living_together_special" // 同居特別障害者
+)
+
+// 扶養の取得・喪失理由で「その他」を表す値です。
+const dependentReasonOther = "other"
+
+type DependentRule struct {
+	ID                                                  int                     `json:"id"`
+	CompanyID                                           int                     `json:"company_id"`
+	EmployeeID                                          int                     `json:"employee_id"`
+	LastName                                            string                  `json:"last_name"`
+	FirstName                                           string                  `json:"first_name"`
+	LastNameKana                                        *string                 `json:"last_name_kana"`
+	FirstNameKana                                       *string                 `json:"first_name_kana"`
+	Gender                                              Gender                  `json:"gender"`
+	Relationship                                        DependentRelationship   `json:"relationship"`
+	BirthDate                                           string                  `json:"birth_date"`
+	ResidenceType                                       DependentResidenceType  `json:"residence_type"`
+	Zipcode1                                            *string                 `json:"zipcode1"`
+	Zipcode2                                            *string                 `json:"zipcode2"`
+	PrefectureCode                                      *int                    `json:"prefecture_code"`
+	Address                                             *string                 `json:"address"`
+	AddressKana                                         *string                 `json:"address_kana"`
+	BasePensionNum                                      *string                 `json:"base_pension_num"`
+	Income                                              int                     `json:"income"`
+	AnnualRevenue                                       int                     `json:"annual_revenue"`
+	DisabilityType                                      DependentDisabilityType `json:"disability_type"`
+	Occupation                                          *string                 `json:"occupation"`
+	AnnualRemittanceAmount                              int                     `json:"annual_remittance_amount"`
+	EmploymentInsuranceReceiveStatus                    *string                 `json:"employment_insurance_receive_status"`
+	EmploymentInsuranceReceivesFrom                     *string                 `json:"employment_insurance_receives_from"`
+	PhoneType                                           *string                 `json:"phone_type"`
+	Phone1                                              *string                 `json:"phone1"`
+	Phone2                                              *string                 `json:"phone2"`
+	Phone3                                              *string                 `json:"phone3"`
+	SocialInsuranceAndTaxDependent                      DependentType           `json:"social_insurance_and_tax_dependent"`
+	SocialInsuranceDependentAcquisitionDate             *string                 `json:"social_insurance_dependent_acquisition_date"`
+	SocialInsuranceDependentAcquisitionReason           string                  `json:"social_insurance_dependent_acquisition_reason"`
+	SocialInsuranceOtherDependentAcquisitionReason      *string                 `json:"social_insurance_other_dependent_acquisition_reason"`
+	SocialInsuranceDependentDisqualificationDate        *string                 `json:"social_insurance_dependent_disqualification_date"`
+	SocialInsuranceDependentDisqualificationReason      string                  `json:"social_insurance_dependent_disqualification_reason"`
+	SocialInsuranceOtherDependentDisqualificationReason *string                 `json:"social_insurance_other_dependent_disqualification_reason"`
+	TaxDependentAcquisitionDate                         *string                 `json:"tax_dependent_acquisition_date"`
+	TaxDependentAcquisitionReason                       string                  `json:"tax_dependent_acquisition_reason"`
+	TaxOtherDependentAcquisitionReason                  *string                 `json:"tax_other_dependent_acquisition_reason"`
+	TaxDependentDisqualificationDate                    *string                 `json:"tax_dependent_disqualification_date"`
+	TaxDependentDisqualificationReason                  string                  `json:"tax_dependent_disqualification_reason"`
+	TaxOtherDependentDisqualificationReason             *string                 `json:"tax_other_dependent_disqualification_reason"`
+	NonResidentDependentsReason                         string                  `json:"non_resident_dependents_reason"`
+}
+
+type ListDependentRulesOpts struct {
+	Year  int // 従業員情報を取得したい年(デフォルト: 当年)
+	Month int // 従業員情報を取得したい月(デフォルト: 当月)
+}
+
+// ListDependentRules は指定した従業員の家族情報をリストで返します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) ListDependentRules(companyID int, employeeID int, opts *ListDependentRulesOpts) ([]DependentRule, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/dependent_rules"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	if opts != nil {
+		if opts.Year > 0 {
+			q.Set("year", strconv.Itoa(opts.Year))
+		}
+		if opts.Month > 0 {
+			q.Set("month", strconv.Itoa(opts.Month))
+		}
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		DependentRules []DependentRule `json:"dependent_rules"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.DependentRules, nil
+}
+
+type DependentRuleRequest struct {
+	CompanyID     int                        `json:"company_id"`
+	Year          *int                       `json:"year,omitempty"`
+	Month         *int                       `json:"month,omitempty"`
+	DependentRule DependentRuleRequestParams `json:"dependent_rule"`
+}
+
+type DependentRuleRequestParams struct {
+	LastName                                            string                  `json:"last_name"`
+	FirstName                                           string                  `json:"first_name"`
+	LastNameKana                                        string                  `json:"last_name_kana,omitempty"`
+	FirstNameKana                                       string                  `json:"first_name_kana,omitempty"`
+	Gender                                              Gender                  `json:"gender"`
+	Relationship                                        DependentRelationship   `json:"relationship"`
+	BirthDate                                           *Date                   `json:"birth_date"`
+	ResidenceType                                       DependentResidenceType  `json:"residence_type,omitempty"`
+	Zipcode1                                            string                  `json:"zipcode1,omitempty"`
+	Zipcode2                                            string                  `json:"zipcode2,omitempty"`
+	PrefectureCode                                      *int                    `json:"prefecture_code,omitempty"`
+	Address                                             string                  `json:"address,omitempty"`
+	AddressKana                                         string                  `json:"address_kana,omitempty"`
+	BasePensionNum                                      string                  `json:"base_pension_num,omitempty"`
+	Income                                              *int                    `json:"income,omitempty"`
+	AnnualRevenue                                       *int                    `json:"annual_revenue,omitempty"`
+	DisabilityType                                      DependentDisabilityType `json:"disability_type,omitempty"`
+	Occupation                                          string                  `json:"occupation,omitempty"`
+	AnnualRemittanceAmount                              *int                    `json:"annual_remittance_amount,omitempty"`
+	SocialInsuranceAndTaxDependent                      DependentType           `json:"social_insurance_and_tax_dependent,omitempty"`
+	SocialInsuranceDependentAcquisitionDate             *Date                   `json:"social_insurance_dependent_acquisition_date,omitempty"`
+	SocialInsuranceDependentAcquisitionReason           string                  `json:"social_insurance_dependent_acquisition_reason,omitempty"`
+	SocialInsuranceOtherDependentAcquisitionReason      string                  `json:"social_insurance_other_dependent_acquisition_reason,omitempty"`
+	SocialInsuranceDependentDisqualificationDate        *Date                   `json:"social_insurance_dependent_disqualification_date,omitempty"`
+	SocialInsuranceDependentDisqualificationReason      string                  `json:"social_insurance_dependent_disqualification_reason,omitempty"`
+	SocialInsuranceOtherDependentDisqualificationReason string                  `json:"social_insurance_other_dependent_disqualification_reason,omitempty"`
+	TaxDependentAcquisitionDate                         *Date                   `json:"tax_dependent_acquisition_date,omitempty"`
+	TaxDependentAcquisitionReason                       string                  `json:"tax_dependent_acquisition_reason,omitempty"`
+	TaxOtherDependentAcquisitionReason                  string                  `json:"tax_other_dependent_acquisition_reason,omitempty"`
+	TaxDependentDisqualificationDate                    *Date                   `json:"tax_dependent_disqualification_date,omitempty"`
+	TaxDependentDisqualificationReason                  string                  `json:"tax_dependent_disqualification_reason,omitempty"`
+	TaxOtherDependentDisqualificationReason             string                  `json:"tax_other_dependent_disqualification_reason,omitempty"`
+	NonResidentDependentsReason                         string                  `json:"non_resident_dependents_reason,omitempty"`
+}
+
+// Validate は freee が要求する項目の組み合わせを満たしているかを検証します。
+// 満たしていない項目がある場合は、それらをまとめたエラーを返します。
+func (p *DependentRuleRequestParams) Validate() error {
+	var errs []error
+	required := func(ok bool, name string) {
+		if !ok {
+			errs = append(errs, errors.New(name+" is required"))
+		}
+	}
+
+	required(p.LastName != "", "last_name")
+	required(p.FirstName != "", "first_name")
+	required(p.Gender != "", "gender")
+	required(p.Relationship != "", "relationship")
+	required(p.BirthDate != nil, "birth_date")
+
+	if p.ResidenceType == DependentResidenceTypeNonResident && p.SocialInsuranceAndTaxDependent.IncludesTax() {
+		required(p.NonResidentDependentsReason != "", "non_resident_dependents_reason")
+	}
+	if p.DisabilityType == DependentDisabilityTypeLivingTogetherSpecial && p.ResidenceType != DependentResidenceTypeLivingTogether {
+		errs = append(errs, errors.New("disability_type living_together_special requires residence_type living_together"))
+	}
+
+	if p.SocialInsuranceAndTaxDependent.IncludesSocialInsurance() {
+		required(p.SocialInsuranceDependentAcquisitionDate != nil, "social_insurance_dependent_acquisition_date")
+		required(p.SocialInsuranceDependentAcquisitionReason != "", "social_insurance_dependent_acquisition_reason")
+	}
+	if p.SocialInsuranceDependentAcquisitionReason == dependentReasonOther {
+		required(p.SocialInsuranceOtherDependentAcquisitionReason != "", "social_insurance_other_dependent_acquisition_reason")
+	}
+	if p.SocialInsuranceDependentDisqualificationDate != nil {
+		required(p.SocialInsuranceDependentDisqualificationReason != "", "social_insurance_dependent_disqualification_reason")
+	}
+	if p.SocialInsuranceDependentDisqualificationReason == dependentReasonOther {
+		required(p.SocialInsuranceOtherDependentDisqualificationReason != "", "social_insurance_other_dependent_disqualification_reason")
+	}
+
+	if p.SocialInsuranceAndTaxDependent.IncludesTax() {
+		required(p.TaxDependentAcquisitionDate != nil, "tax_dependent_acquisition_date")
+		required(p.TaxDependentAcquisitionReason != "", "tax_dependent_acquisition_reason")
+	}
+	if p.TaxDependentAcquisitionReason == dependentReasonOther {
+		required(p.TaxOtherDependentAcquisitionReason != "", "tax_other_dependent_acquisition_reason")
+	}
+	if p.TaxDependentDisqualificationDate != nil {
+		required(p.TaxDependentDisqualificationReason != "", "tax_dependent_disqualification_reason")
+	}
+	if p.TaxDependentDisqualificationReason == dependentReasonOther {
+		required(p.TaxOtherDependentDisqualificationReason != "", "tax_other_dependent_disqualification_reason")
+	}
+
+	return errors.Join(errs...)
+}
+
+// CreateDependentRule は指定した従業員の家族情報を新規作成します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+// - 送信前に DependentRuleRequestParams.Validate で項目の組み合わせを検証します。
+func (c *Client) CreateDependentRule(employeeID int, request *DependentRuleRequest) (DependentRule, error) {
+	if request == nil {
+		return DependentRule{}, errors.New("request is nil")
+	}
+	if err := request.DependentRule.Validate(); err != nil {
+		return DependentRule{}, err
+	}
+
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/dependent_rules"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return DependentRule{}, err
+	}
+
+	result := struct {
+		DependentRule DependentRule `json:"dependent_rule"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return DependentRule{}, err
+	}
+
+	return result.DependentRule, nil
+}
+
+// UpdateDependentRule は指定した従業員の家族情報を更新します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+// - 送信前に DependentRuleRequestParams.Validate で項目の組み合わせを検証します。
+func (c *Client) UpdateDependentRule(employeeID int, dependentRuleID int, request *DependentRuleRequest) (DependentRule, error) {
+	if request == nil {
+		return DependentRule{}, errors.New("request is nil")
+	}
+	if err := request.DependentRule.Validate(); err != nil {
+		return DependentRule{}, err
+	}
+
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/dependent_rules/" + url.PathEscape(strconv.Itoa(dependentRuleID))
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return DependentRule{}, err
+	}
+
+	result := struct {
+		DependentRule DependentRule `json:"dependent_rule"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return DependentRule{}, err
+	}
+
+	return result.DependentRule, nil
+}
+
+// DeleteDependentRule は指定した従業員の家族情報を削除します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) DeleteDependentRule(companyID int, employeeID int, dependentRuleID int) error {
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/dependent_rules/" + url.PathEscape(strconv.Itoa(dependentRuleID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodDelete, u, q, nil)
+	if err != nil {
+		return err
+	}
+	resp.Close()
+	return nil
+}
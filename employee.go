@@ -8,6 +8,87 @@ import (
 
 // https://developer.freee.co.jp/reference/hr/reference#operations-tag-従業員
 
+// Gender は性別を表します。
+type Gender string
+
+const (
+	GenderMale       Gender = "male"       // 男性
+	GenderFemale     Gender = "female"     // 女性
+	GenderUnselected Gender = "unselected" // 未選択
+)
+
+func (g Gender) String() string {
+	return string(g)
+}
+
+// IsValid は既知の性別かどうかを返します。
+func (g Gender) IsValid() bool {
+	return isValidEnum(g, GenderMale, GenderFemale, GenderUnselected)
+}
+
+func (g Gender) MarshalJSON() ([]byte, error) {
+	return marshalEnum(g)
+}
+
+func (g *Gender) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, g)
+}
+
+// EmploymentType は雇用形態を表します。
+type EmploymentType string
+
+const (
+	EmploymentTypeBoardMember EmploymentType = "board-member" // 役員
+	EmploymentTypeRegular     EmploymentType = "regular"      // 正社員
+	EmploymentTypeContract    EmploymentType = "contract"     // 契約社員
+	EmploymentTypePartTime    EmploymentType = "part-time"    // パート・アルバイト
+	EmploymentTypeTemporary   EmploymentType = "temporary"    // 派遣社員
+	EmploymentTypeOther       EmploymentType = "other"        // その他
+)
+
+func (t EmploymentType) String() string {
+	return string(t)
+}
+
+// IsValid は既知の雇用形態かどうかを返します。
+func (t EmploymentType) IsValid() bool {
+	return isValidEnum(t, EmploymentTypeBoardMember, EmploymentTypeRegular, EmploymentTypeContract, EmploymentTypePartTime, EmploymentTypeTemporary, EmploymentTypeOther)
+}
+
+func (t EmploymentType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(t)
+}
+
+func (t *EmploymentType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, t)
+}
+
+// PayCalcType は給与形態を表します。
+type PayCalcType string
+
+const (
+	PayCalcTypeMonthly PayCalcType = "monthly" // 月給
+	PayCalcTypeDaily   PayCalcType = "daily"   // 日給
+	PayCalcTypeHourly  PayCalcType = "hourly"  // 時給
+)
+
+func (t PayCalcType) String() string {
+	return string(t)
+}
+
+// IsValid は既知の給与形態かどうかを返します。
+func (t PayCalcType) IsValid() bool {
+	return isValidEnum(t, PayCalcTypeMonthly, PayCalcTypeDaily, PayCalcTypeHourly)
+}
+
+func (t PayCalcType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(t)
+}
+
+func (t *PayCalcType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, t)
+}
+
 // DeleteEmployee は指定したIDの従業員を削除します。
 // 注意点
 // - 管理者権限を持ったユーザーのみ実行可能です。
@@ -75,50 +156,76 @@ func (c *Client) ListCompaniesEmployees(companyID int, opts *ListAllEmployeesOpt
 	return employees, nil
 }
 
+// ListAllCompaniesEmployees は ListCompaniesEmployees をページングしながら呼び出し、指定した事業所に所属する従業員をすべて返します。
+// opts の Limit と Offset は無視されます。
+func (c *Client) ListAllCompaniesEmployees(companyID int, opts *ListAllEmployeesOpts) ([]CompaniesEmployee, error) {
+	o := ListAllEmployeesOpts{}
+	if opts != nil {
+		o = *opts
+	}
+	o.Limit = 100
+	o.Offset = 0
+
+	all := []CompaniesEmployee{}
+	for {
+		employees, err := c.ListCompaniesEmployees(companyID, &o)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, employees...)
+		if len(employees) < o.Limit {
+			return all, nil
+		}
+		o.Offset += len(employees)
+	}
+}
+
+type EmployeeProfileRule struct {
+	ID                        int             `json:"id"`
+	CompanyID                 int             `json:"company_id"`
+	EmployeeID                int             `json:"employee_id"`
+	LastName                  string          `json:"last_name"`
+	FirstName                 string          `json:"first_name"`
+	LastNameKana              string          `json:"last_name_kana"`
+	FirstNameKana             string          `json:"first_name_kana"`
+	Zipcode1                  *string         `json:"zipcode1"`
+	Zipcode2                  *string         `json:"zipcode2"`
+	PrefectureCode            *int            `json:"prefecture_code"`
+	Address                   *string         `json:"address"`
+	AddressKana               *string         `json:"address_kana"`
+	Phone1                    *string         `json:"phone1"`
+	Phone2                    *string         `json:"phone2"`
+	Phone3                    *string         `json:"phone3"`
+	ResidentialZipcode1       *string         `json:"residential_zipcode1"`
+	ResidentialZipcode2       *string         `json:"residential_zipcode2"`
+	ResidentialPrefectureCode *int            `json:"residential_prefecture_code"`
+	ResidentialAddress        *string         `json:"residential_address"`
+	ResidentialAddressKana    *string         `json:"residential_address_kana"`
+	EmploymentType            *EmploymentType `json:"employment_type"`
+	Title                     *string         `json:"title"`
+	Gender                    Gender          `json:"gender"`
+	Married                   bool            `json:"married"`
+	IsWorkingStudent          bool            `json:"is_working_student"`
+	WidowType                 string          `json:"widow_type"`
+	DisabilityType            string          `json:"disability_type"`
+	Email                     *string         `json:"email"`
+	HouseholderName           string          `json:"householder_name"`
+	Householder               *string         `json:"householder"`
+}
+
 type Employee struct {
-	ID                                 int     `json:"id"`
-	CompanyID                          int     `json:"company_id"`
-	Num                                *string `json:"num"`
-	DisplayName                        string  `json:"display_name"`
-	BasePensionNum                     *string `json:"base_pension_num"`
-	EmploymentInsuranceReferenceNumber string  `json:"employment_insurance_reference_number"`
-	BirthDate                          string  `json:"birth_date"`
-	EntryDate                          string  `json:"entry_date"`
-	RetireDate                         *string `json:"retire_date"`
-	UserID                             *int    `json:"user_id"`
-	ProfileRule                        struct {
-		ID                        int     `json:"id"`
-		CompanyID                 int     `json:"company_id"`
-		EmployeeID                int     `json:"employee_id"`
-		LastName                  string  `json:"last_name"`
-		FirstName                 string  `json:"first_name"`
-		LastNameKana              string  `json:"last_name_kana"`
-		FirstNameKana             string  `json:"first_name_kana"`
-		Zipcode1                  *string `json:"zipcode1"`
-		Zipcode2                  *string `json:"zipcode2"`
-		PrefectureCode            *int    `json:"prefecture_code"`
-		Address                   *string `json:"address"`
-		AddressKana               *string `json:"address_kana"`
-		Phone1                    *string `json:"phone1"`
-		Phone2                    *string `json:"phone2"`
-		Phone3                    *string `json:"phone3"`
-		ResidentialZipcode1       *string `json:"residential_zipcode1"`
-		ResidentialZipcode2       *string `json:"residential_zipcode2"`
-		ResidentialPrefectureCode *int    `json:"residential_prefecture_code"`
-		ResidentialAddress        *string `json:"residential_address"`
-		ResidentialAddressKana    *string `json:"residential_address_kana"`
-		EmploymentType            *string `json:"employment_type"`
-		Title                     *string `json:"title"`
-		Gender                    string  `json:"gender"`
-		Married                   bool    `json:"married"`
-		IsWorkingStudent          bool    `json:"is_working_student"`
-		WidowType                 string  `json:"widow_type"`
-		DisabilityType            string  `json:"disability_type"`
-		Email                     *string `json:"email"`
-		HouseholderName           string  `json:"householder_name"`
-		Householder               *string `json:"householder"`
-	} `json:"profile_rule"`
-	HealthInsuranceRule struct {
+	ID                                 int                 `json:"id"`
+	CompanyID                          int                 `json:"company_id"`
+	Num                                *string             `json:"num"`
+	DisplayName                        string              `json:"display_name"`
+	BasePensionNum                     *string             `json:"base_pension_num"`
+	EmploymentInsuranceReferenceNumber string              `json:"employment_insurance_reference_number"`
+	BirthDate                          string              `json:"birth_date"`
+	EntryDate                          string              `json:"entry_date"`
+	RetireDate                         *string             `json:"retire_date"`
+	UserID                             *int                `json:"user_id"`
+	ProfileRule                        EmployeeProfileRule `json:"profile_rule"`
+	HealthInsuranceRule                struct {
 		ID                                          int      `json:"id"`
 		CompanyID                                   int      `json:"company_id"`
 		EmployeeID                                  int      `json:"employee_id"`
@@ -156,50 +263,7 @@ type Employee struct {
 		WelfarePensionInsuranceBonusCalcType                string   `json:"welfare_pension_insurance_bonus_calc_type"`
 		WelfarePensionInsuranceSalaryCalcType               string   `json:"welfare_pension_insurance_salary_calc_type"`
 	} `json:"welfare_pension_insurance_rule"`
-	DependentRules []struct {
-		ID                                                  int     `json:"id"`
-		CompanyID                                           int     `json:"company_id"`
-		EmployeeID                                          int     `json:"employee_id"`
-		LastName                                            string  `json:"last_name"`
-		FirstName                                           string  `json:"first_name"`
-		LastNameKana                                        *string `json:"last_name_kana"`
-		FirstNameKana                                       *string `json:"first_name_kana"`
-		Gender                                              string  `json:"gender"`
-		Relationship                                        string  `json:"relationship"`
-		BirthDate                                           string  `json:"birth_date"`
-		ResidenceType                                       string  `json:"residence_type"`
-		Zipcode1                                            *string `json:"zipcode1"`
-		Zipcode2                                            *string `json:"zipcode2"`
-		PrefectureCode                                      *int    `json:"prefecture_code"`
-		Address                                             *string `json:"address"`
-		AddressKana                                         *string `json:"address_kana"`
-		BasePensionNum                                      *string `json:"base_pension_num"`
-		Income                                              int     `json:"income"`
-		AnnualRevenue                                       int     `json:"annual_revenue"`
-		DisabilityType                                      string  `json:"disability_type"`
-		Occupation                                          *string `json:"occupation"`
-		AnnualRemittanceAmount                              int     `json:"annual_remittance_amount"`
-		EmploymentInsuranceReceiveStatus                    *string `json:"employment_insurance_receive_status"`
-		EmploymentInsuranceReceivesFrom                     *string `json:"employment_insurance_receives_from"`
-		PhoneType                                           *string `json:"phone_type"`
-		Phone1                                              *string `json:"phone1"`
-		Phone2                                              *string `json:"phone2"`
-		Phone3                                              *string `json:"phone3"`
-		SocialInsuranceAndTaxDependent                      string  `json:"social_insurance_and_tax_dependent"`
-		SocialInsuranceDependentAcquisitionDate             *string `json:"social_insurance_dependent_acquisition_date"`
-		SocialInsuranceDependentAcquisitionReason           string  `json:"social_insurance_dependent_acquisition_reason"`
-		SocialInsuranceOtherDependentAcquisitionReason      *string `json:"social_insurance_other_dependent_acquisition_reason"`
-		SocialInsuranceDependentDisqualificationDate        *string `json:"social_insurance_dependent_disqualification_date"`
-		SocialInsuranceDependentDisqualificationReason      string  `json:"social_insurance_dependent_disqualification_reason"`
-		SocialInsuranceOtherDependentDisqualificationReason *string `json:"social_insurance_other_dependent_disqualification_reason"`
-		TaxDependentAcquisitionDate                         *string `json:"tax_dependent_acquisition_date"`
-		TaxDependentAcquisitionReason                       string  `json:"tax_dependent_acquisition_reason"`
-		TaxOtherDependentAcquisitionReason                  *string `json:"tax_other_dependent_acquisition_reason"`
-		TaxDependentDisqualificationDate                    *string `json:"tax_dependent_disqualification_date"`
-		TaxDependentDisqualificationReason                  string  `json:"tax_dependent_disqualification_reason"`
-		TaxOtherDependentDisqualificationReason             *string `json:"tax_other_dependent_disqualification_reason"`
-		NonResidentDependentsReason                         string  `json:"non_resident_dependents_reason"`
-	} `json:"dependent_rules"`
+	DependentRules  []DependentRule `json:"dependent_rules"`
 	BankAccountRule struct {
 		ID             int     `json:"id"`
 		CompanyID      int     `json:"company_id"`
@@ -215,14 +279,15 @@ type Employee struct {
 		AccountType    *string `json:"account_type"`
 	} `json:"bank_account_rule"`
 	BasicPayRule struct {
-		ID          int    `json:"id"`
-		CompanyID   int    `json:"company_id"`
-		EmployeeID  int    `json:"employee_id"`
-		PayCalcType string `json:"pay_calc_type"`
-		PayAmount   int    `json:"pay_amount"`
+		ID          int         `json:"id"`
+		CompanyID   int         `json:"company_id"`
+		EmployeeID  int         `json:"employee_id"`
+		PayCalcType PayCalcType `json:"pay_calc_type"`
+		PayAmount   int         `json:"pay_amount"`
 	} `json:"basic_pay_rule"`
-	PayrollCalculation           bool    `json:"payroll_calculation"`
-	CompanyReferenceDateRuleName *string `json:"company_reference_date_rule_name"`
+	CustomFieldRules             []CustomFieldRule `json:"custom_field_rules"`
+	PayrollCalculation           bool              `json:"payroll_calculation"`
+	CompanyReferenceDateRuleName *string           `json:"company_reference_date_rule_name"`
 }
 
 type ListEmployeesOpts struct {
@@ -307,20 +372,20 @@ type CreateEmployeeRequest struct {
 }
 
 type CreateEmployeeRequestEmployee struct {
-	Num                          string `json:"num,omitempty"`
-	WorkingHoursSystemName       string `json:"working_hours_system_name,omitempty"`
-	CompanyReferenceDateRuleName string `json:"company_reference_date_rule_name,omitempty"`
-	LastName                     string `json:"last_name"`
-	FirstName                    string `json:"first_name"`
-	LastNameKana                 string `json:"last_name_kana"`
-	FirstNameKana                string `json:"first_name_kana"`
-	BirthDate                    Date   `json:"birth_date"`
-	EntryDate                    Date   `json:"entry_date"`
-	PayCalcType                  string `json:"pay_calc_type,omitempty"`
-	PayAmount                    *int   `json:"pay_amount,omitempty"`
-	Gender                       string `json:"gender,omitempty"`
-	Married                      *bool  `json:"married,omitempty"`
-	NoPayrollCalculation         *bool  `json:"no_payroll_calculation,omitempty"`
+	Num                          string      `json:"num,omitempty"`
+	WorkingHoursSystemName       string      `json:"working_hours_system_name,omitempty"`
+	CompanyReferenceDateRuleName string      `json:"company_reference_date_rule_name,omitempty"`
+	LastName                     string      `json:"last_name"`
+	FirstName                    string      `json:"first_name"`
+	LastNameKana                 string      `json:"last_name_kana"`
+	FirstNameKana                string      `json:"first_name_kana"`
+	BirthDate                    Date        `json:"birth_date"`
+	EntryDate                    Date        `json:"entry_date"`
+	PayCalcType                  PayCalcType `json:"pay_calc_type,omitempty"`
+	PayAmount                    *int        `json:"pay_amount,omitempty"`
+	Gender                       Gender      `json:"gender,omitempty"`
+	Married                      *bool       `json:"married,omitempty"`
+	NoPayrollCalculation         *bool       `json:"no_payroll_calculation,omitempty"`
 }
 
 // CreateEmployee は従業員を新規作成します。
@@ -0,0 +1,254 @@
+package freee
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// EmployeeChangeKind は従業員情報の項目の変更の種類を表します。
+type EmployeeChangeKind string
+
+const (
+	EmployeeChangeAdded    EmployeeChangeKind = "added"    // 項目(扶養親族など)が追加された
+	EmployeeChangeRemoved  EmployeeChangeKind = "removed"  // 項目が削除された
+	EmployeeChangeModified EmployeeChangeKind = "modified" // 値が変更された
+)
+
+// EmployeeChange は従業員情報の項目ごとの変更です。
+type EmployeeChange struct {
+	// Path は変更された項目の JSON のパスです。
+	// ID を持つ要素の配列は ID で対応付けます。(例: profile_rule.address, dependent_rules[id=12].last_name)
+	Path   string
+	Kind   EmployeeChangeKind
+	Before any // 変更前の値(追加の場合は nil)
+	After  any // 変更後の値(削除の場合は nil)
+}
+
+func (c EmployeeChange) String() string {
+	return fmt.Sprintf("%s: %s %v -> %v", c.Path, c.Kind, formatChangeValue(c.Before), formatChangeValue(c.After))
+}
+
+func formatChangeValue(v any) string {
+	if v == nil {
+		return "null"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// EmployeeDiff は2つの年月の従業員情報の差分です。
+type EmployeeDiff struct {
+	EmployeeID int
+	FromYear   int
+	FromMonth  int
+	ToYear     int
+	ToMonth    int
+	Before     Employee
+	After      Employee
+	Changes    []EmployeeChange
+}
+
+// Filter は Path が prefix で始まる変更を返します。(例: "profile_rule.", "dependent_rules")
+func (d *EmployeeDiff) Filter(prefix string) []EmployeeChange {
+	changes := []EmployeeChange{}
+	for _, c := range d.Changes {
+		if strings.HasPrefix(c.Path, prefix) {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// DiffEmployee は指定した従業員の fromYear 年 fromMonth 月と toYear 年 toMonth 月の従業員情報を GetEmployee で取得し、その差分を返します。
+func (c *Client) DiffEmployee(companyID int, employeeID int, fromYear int, fromMonth int, toYear int, toMonth int) (*EmployeeDiff, error) {
+	before, err := c.GetEmployee(companyID, employeeID, fromYear, fromMonth)
+	if err != nil {
+		return nil, err
+	}
+	after, err := c.GetEmployee(companyID, employeeID, toYear, toMonth)
+	if err != nil {
+		return nil, err
+	}
+	return &EmployeeDiff{
+		EmployeeID: employeeID,
+		FromYear:   fromYear,
+		FromMonth:  fromMonth,
+		ToYear:     toYear,
+		ToMonth:    toMonth,
+		Before:     before,
+		After:      after,
+		Changes:    DiffEmployees(&before, &after),
+	}, nil
+}
+
+// DiffEmployees は before と after の従業員情報の差分を、項目の定義順に返します。
+// 入れ子のルール(保険・口座・扶養親族・カスタム項目など)も含めて比較します。
+func DiffEmployees(before *Employee, after *Employee) []EmployeeChange {
+	changes := []EmployeeChange{}
+	diffValue("", reflect.ValueOf(before).Elem(), reflect.ValueOf(after).Elem(), &changes)
+	return changes
+}
+
+// sliceKeyFields は配列の要素を対応付けるための JSON の項目名です。
+var sliceKeyFields = []string{"id", "custom_field_id"}
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// isJSONMarshaler は t が独自の MarshalJSON を持つ型(Date・DateTime・Time など)かどうかを返します。
+func isJSONMarshaler(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType)
+}
+
+func jsonFieldName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = f.Name
+	}
+	return name
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// interfaceOf は変更の値として v を返します。nil ポインタは nil を返します。
+func interfaceOf(v reflect.Value) any {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Type() == rawMessageType {
+		if v.Len() == 0 || string(v.Bytes()) == "null" {
+			return nil
+		}
+		return json.RawMessage(v.Bytes())
+	}
+	if !v.Type().Implements(jsonMarshalerType) && reflect.PointerTo(v.Type()).Implements(jsonMarshalerType) {
+		// MarshalJSON と String がポインタのメソッドの型は、ポインタで返さないと JSON や文字列に変換できないため
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		return p.Interface()
+	}
+	return v.Interface()
+}
+
+func diffValue(path string, a reflect.Value, b reflect.Value, changes *[]EmployeeChange) {
+	switch {
+	case a.Kind() == reflect.Pointer:
+		switch {
+		case a.IsNil() && b.IsNil():
+		case a.IsNil() || b.IsNil():
+			*changes = append(*changes, EmployeeChange{Path: path, Kind: EmployeeChangeModified, Before: interfaceOf(a), After: interfaceOf(b)})
+		default:
+			diffValue(path, a.Elem(), b.Elem(), changes)
+		}
+	case a.Type() != rawMessageType && isJSONMarshaler(a.Type()):
+		// Date・DateTime・Time は time.Time の非公開の項目を持つため、JSON の値で比較します
+		if av, bv := interfaceOf(a), interfaceOf(b); formatChangeValue(av) != formatChangeValue(bv) {
+			*changes = append(*changes, EmployeeChange{Path: path, Kind: EmployeeChangeModified, Before: av, After: bv})
+		}
+	case a.Kind() == reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			name := jsonFieldName(f)
+			if !f.IsExported() || name == "" {
+				continue
+			}
+			diffValue(joinPath(path, name), a.Field(i), b.Field(i), changes)
+		}
+	case a.Kind() == reflect.Slice && a.Type() != rawMessageType:
+		diffSlice(path, a, b, changes)
+	default:
+		if av, bv := interfaceOf(a), interfaceOf(b); !reflect.DeepEqual(av, bv) {
+			*changes = append(*changes, EmployeeChange{Path: path, Kind: EmployeeChangeModified, Before: av, After: bv})
+		}
+	}
+}
+
+// sliceKey は配列の要素 v を対応付けるキーを返します。キーを持たない要素の場合は false を返します。
+func sliceKey(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "", false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+	for _, key := range sliceKeyFields {
+		for i := 0; i < v.NumField(); i++ {
+			if jsonFieldName(v.Type().Field(i)) == key {
+				return fmt.Sprintf("%s=%v", key, v.Field(i).Interface()), true
+			}
+		}
+	}
+	return "", false
+}
+
+func diffSlice(path string, a reflect.Value, b reflect.Value, changes *[]EmployeeChange) {
+	type keyed struct {
+		key   string
+		value reflect.Value
+	}
+	keys := func(s reflect.Value) ([]keyed, bool) {
+		items := make([]keyed, 0, s.Len())
+		for i := 0; i < s.Len(); i++ {
+			k, ok := sliceKey(s.Index(i))
+			if !ok {
+				return nil, false
+			}
+			items = append(items, keyed{k, s.Index(i)})
+		}
+		return items, true
+	}
+
+	aItems, aOK := keys(a)
+	bItems, bOK := keys(b)
+	if !aOK || !bOK {
+		// キーを持たない要素は添字で対応付けます
+		aItems, bItems = nil, nil
+		for i := 0; i < a.Len(); i++ {
+			aItems = append(aItems, keyed{fmt.Sprint(i), a.Index(i)})
+		}
+		for i := 0; i < b.Len(); i++ {
+			bItems = append(bItems, keyed{fmt.Sprint(i), b.Index(i)})
+		}
+	}
+
+	bByKey := make(map[string]reflect.Value, len(bItems))
+	for _, item := range bItems {
+		bByKey[item.key] = item.value
+	}
+	seen := make(map[string]bool, len(aItems))
+	for _, item := range aItems {
+		seen[item.key] = true
+		elemPath := path + "[" + item.key + "]"
+		if bv, ok := bByKey[item.key]; ok {
+			diffValue(elemPath, item.value, bv, changes)
+		} else {
+			*changes = append(*changes, EmployeeChange{Path: elemPath, Kind: EmployeeChangeRemoved, Before: interfaceOf(item.value)})
+		}
+	}
+	for _, item := range bItems {
+		if !seen[item.key] {
+			*changes = append(*changes, EmployeeChange{Path: path + "[" + item.key + "]", Kind: EmployeeChangeAdded, After: interfaceOf(item.value)})
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package freee
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// ErrEmployeeNotFound は EmployeeDirectory で従業員が見つからなかった場合のエラーです。
+var ErrEmployeeNotFound = errors.New("employee not found")
+
+type EmployeeDirectoryOpts struct {
+	// RefreshInterval は従業員の一覧を再取得する間隔です。最後の取得からこの時間が経過した後の検索時に再取得します。
+	// 0 の場合は Refresh を呼び出すまで再取得しません。
+	RefreshInterval time.Duration
+	// CachePath を指定すると、取得した従業員の一覧をこのファイルに保存し、次回の NewEmployeeDirectory で読み込みます。
+	// 読み込んだ一覧が RefreshInterval より古い場合は再取得します。
+	CachePath string
+}
+
+// EmployeeDirectory は事業所の従業員をメールアドレス・従業員番号・ユーザーID・表示名から検索するための索引です。
+// 給与計算対象外の従業員と退職済みの従業員も含みます。
+// 複数のゴルーチンから同時に使用できます。
+type EmployeeDirectory struct {
+	client    *Client
+	companyID int
+	opts      EmployeeDirectoryOpts
+
+	refreshMu sync.Mutex // Refresh の同時実行を防ぎます
+
+	mu          sync.RWMutex
+	employees   []CompaniesEmployee
+	refreshedAt time.Time
+	byEmail     map[string]int
+	byNum       map[string]int
+	byUserID    map[int]int
+	byName      map[string][]int
+}
+
+// employeeDirectoryCache は EmployeeDirectory をファイルに保存する形式です。
+type employeeDirectoryCache struct {
+	CompanyID   int                 `json:"company_id"`
+	RefreshedAt time.Time           `json:"refreshed_at"`
+	Employees   []CompaniesEmployee `json:"employees"`
+}
+
+// NewEmployeeDirectory は指定した事業所の EmployeeDirectory を作成します。
+// opts.CachePath に有効な保存済みの一覧がない場合は、ListAllCompaniesEmployees で従業員の一覧を取得します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) NewEmployeeDirectory(companyID int, opts *EmployeeDirectoryOpts) (*EmployeeDirectory, error) {
+	d := &EmployeeDirectory{
+		client:    c,
+		companyID: companyID,
+	}
+	if opts != nil {
+		d.opts = *opts
+	}
+
+	if d.opts.CachePath != "" {
+		if cache, err := d.load(); err == nil && cache.CompanyID == companyID {
+			d.set(cache.Employees, cache.RefreshedAt)
+			if !d.stale() {
+				return d, nil
+			}
+		}
+	}
+	if err := d.Refresh(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Refresh は従業員の一覧を再取得して索引を作り直します。
+// CachePath を指定している場合は取得した一覧をファイルに保存します。
+func (d *EmployeeDirectory) Refresh() error {
+	d.refreshMu.Lock()
+	defer d.refreshMu.Unlock()
+	return d.refresh()
+}
+
+func (d *EmployeeDirectory) refresh() error {
+	employees, err := d.client.ListAllCompaniesEmployees(d.companyID, &ListAllEmployeesOpts{WithNoPayrollCalculation: true})
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	d.set(employees, now)
+
+	if d.opts.CachePath != "" {
+		if err := d.save(employeeDirectoryCache{CompanyID: d.companyID, RefreshedAt: now, Employees: employees}); err != nil {
+			return fmt.Errorf("failed to save employee directory: %w", err)
+		}
+	}
+	return nil
+}
+
+// RefreshedAt は従業員の一覧を最後に取得した時刻を返します。
+func (d *EmployeeDirectory) RefreshedAt() time.Time {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.refreshedAt
+}
+
+// Employees は従業員の一覧を返します。
+func (d *EmployeeDirectory) Employees() ([]CompaniesEmployee, error) {
+	if err := d.refreshIfStale(); err != nil {
+		return nil, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	employees := make([]CompaniesEmployee, len(d.employees))
+	copy(employees, d.employees)
+	return employees, nil
+}
+
+// LookupByEmail はメールアドレスが一致する従業員を返します。大文字と小文字は区別しません。
+func (d *EmployeeDirectory) LookupByEmail(email string) (CompaniesEmployee, error) {
+	return d.lookup(func() (int, bool) {
+		i, ok := d.byEmail[normalizeEmail(email)]
+		return i, ok
+	})
+}
+
+// LookupByNum は従業員番号が一致する従業員を返します。
+func (d *EmployeeDirectory) LookupByNum(num string) (CompaniesEmployee, error) {
+	return d.lookup(func() (int, bool) {
+		i, ok := d.byNum[normalizeNum(num)]
+		return i, ok
+	})
+}
+
+// LookupByUserID はユーザーIDが一致する従業員を返します。
+func (d *EmployeeDirectory) LookupByUserID(userID int) (CompaniesEmployee, error) {
+	return d.lookup(func() (int, bool) {
+		i, ok := d.byUserID[userID]
+		return i, ok
+	})
+}
+
+// LookupByDisplayName は表示名が一致する従業員をすべて返します。
+// ひらがなとカタカナ、全角と半角、大文字と小文字、空白の有無の違いは無視して比較します。
+// 表示名の表記そのものと比較するため、漢字の表示名の従業員は読み仮名では検索できません。
+// 一致する従業員がいない場合は ErrEmployeeNotFound を返します。
+func (d *EmployeeDirectory) LookupByDisplayName(name string) ([]CompaniesEmployee, error) {
+	if err := d.refreshIfStale(); err != nil {
+		return nil, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	indexes := d.byName[normalizeName(name)]
+	if len(indexes) == 0 {
+		return nil, ErrEmployeeNotFound
+	}
+	employees := make([]CompaniesEmployee, 0, len(indexes))
+	for _, i := range indexes {
+		employees = append(employees, d.employees[i])
+	}
+	return employees, nil
+}
+
+func (d *EmployeeDirectory) lookup(find func() (int, bool)) (CompaniesEmployee, error) {
+	if err := d.refreshIfStale(); err != nil {
+		return CompaniesEmployee{}, err
+	}
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	i, ok := find()
+	if !ok {
+		return CompaniesEmployee{}, ErrEmployeeNotFound
+	}
+	return d.employees[i], nil
+}
+
+func (d *EmployeeDirectory) stale() bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.opts.RefreshInterval > 0 && time.Since(d.refreshedAt) >= d.opts.RefreshInterval
+}
+
+// refreshIfStale は一覧が古い場合に再取得します。
+// 同時に呼び出された場合は、最初の呼び出しの再取得が終わった後に改めて判定するため、再取得は1回だけ行われます。
+func (d *EmployeeDirectory) refreshIfStale() error {
+	if !d.stale() {
+		return nil
+	}
+	d.refreshMu.Lock()
+	defer d.refreshMu.Unlock()
+	if !d.stale() {
+		return nil
+	}
+	return d.refresh()
+}
+
+// set は従業員の一覧を置き換えて索引を作り直します。
+func (d *EmployeeDirectory) set(employees []CompaniesEmployee, refreshedAt time.Time) {
+	byEmail := map[string]int{}
+	byNum := map[string]int{}
+	byUserID := map[int]int{}
+	byName := map[string][]int{}
+	for i, e := range employees {
+		if e.Email != nil && *e.Email != "" {
+			byEmail[normalizeEmail(*e.Email)] = i
+		}
+		if e.Num != nil && *e.Num != "" {
+			byNum[normalizeNum(*e.Num)] = i
+		}
+		if e.UserID > 0 {
+			byUserID[e.UserID] = i
+		}
+		name := normalizeName(e.DisplayName)
+		byName[name] = append(byName[name], i)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.employees = employees
+	d.refreshedAt = refreshedAt
+	d.byEmail = byEmail
+	d.byNum = byNum
+	d.byUserID = byUserID
+	d.byName = byName
+}
+
+func (d *EmployeeDirectory) load() (employeeDirectoryCache, error) {
+	var cache employeeDirectoryCache
+	b, err := os.ReadFile(d.opts.CachePath)
+	if err != nil {
+		return cache, err
+	}
+	err = json.Unmarshal(b, &cache)
+	return cache, err
+}
+
+// save は一時ファイルに書き込んでから置き換えることで、書き込み途中のファイルが読み込まれることを防ぎます。
+func (d *EmployeeDirectory) save(cache employeeDirectoryCache) error {
+	b, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(d.opts.CachePath), filepath.Base(d.opts.CachePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), d.opts.CachePath)
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func normalizeNum(num string) string {
+	return strings.TrimSpace(foldWidth(num))
+}
+
+// normalizeName は表示名を比較するために、空白を除きカタカナをひらがなに、全角英数字を半角にそろえます。
+func normalizeName(name string) string {
+	var b strings.Builder
+	for _, r := range foldWidth(name) {
+		switch {
+		case unicode.IsSpace(r) || r == '・':
+			continue
+		case r >= 'ァ' && r <= 'ヶ':
+			r -= 'ァ' - 'ぁ'
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+const (
+	halfWidthKatakana = "ｦｧｨｩｪｫｬｭｮｯｰｱｲｳｴｵｶｷｸｹｺｻｼｽｾｿﾀﾁﾂﾃﾄﾅﾆﾇﾈﾉﾊﾋﾌﾍﾎﾏﾐﾑﾒﾓﾔﾕﾖﾗﾘﾙﾚﾛﾜﾝ"
+	fullWidthKatakana = "ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン"
+)
+
+var halfToFullKatakana = func() map[rune]rune {
+	m := map[rune]rune{}
+	full := []rune(fullWidthKatakana)
+	for i, r := range []rune(halfWidthKatakana) {
+		m[r] = full[i]
+	}
+	return m
+}()
+
+// foldWidth は全角英数字・記号を半角に、半角カタカナを全角にそろえます。濁点・半濁点は直前の文字と結合します。
+func foldWidth(s string) string {
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= '！' && r <= '～':
+			r -= '！' - '!'
+		case r == '　':
+			r = ' '
+		case r == 'ﾞ' || r == 'ﾟ':
+			if n := len(out); n > 0 {
+				if c, ok := combineSoundMark(out[n-1], r == 'ﾟ'); ok {
+					out[n-1] = c
+					continue
+				}
+			}
+		default:
+			if f, ok := halfToFullKatakana[r]; ok {
+				r = f
+			}
+		}
+		out = append(out, r)
+	}
+	return string(out)
+}
+
+// combineSoundMark はカタカナ r に濁点(semi が true の場合は半濁点)を付けた文字を返します。
+// 濁音は清音の次、半濁音は清音の2つ後の符号位置にあります。
+func combineSoundMark(r rune, semi bool) (rune, bool) {
+	switch {
+	case semi && strings.ContainsRune("ハヒフヘホ", r):
+		return r + 2, true
+	case semi:
+		return r, false
+	case r == 'ウ':
+		return 'ヴ', true
+	case strings.ContainsRune("カキクケコサシスセソタチツテトハヒフヘホ", r):
+		return r + 1, true
+	}
+	return r, false
+}
@@ -0,0 +1,29 @@
+package freee
+
+import (
+	"encoding/json"
+	"slices"
+)
+
+// 文字列の列挙型に共通する処理です。
+// 未知の値もエラーにせずそのまま保持するため、サーバーが新しい値を返しても読み書きできます。
+
+func marshalEnum[T ~string](v T) ([]byte, error) {
+	return json.Marshal(string(v))
+}
+
+func unmarshalEnum[T ~string](b []byte, v *T) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*v = T(s)
+	return nil
+}
+
+func isValidEnum[T ~string](v T, values ...T) bool {
+	return slices.Contains(values, v)
+}
@@ -0,0 +1,135 @@
+package holiday
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Calendar は国民の祝日に会社独自の休業日を加えたカレンダーです。
+// 複数のゴルーチンから同時に使用できます。
+type Calendar struct {
+	mu             sync.RWMutex
+	closedDays     map[day]string                // 特定の日付の休業日
+	annualClosed   map[time.Month]map[int]string // 毎年の休業日(年末年始など)
+	closedWeekdays []time.Weekday
+	workingDays    map[day]bool // 祝日でも休業日としない日
+}
+
+// NewCalendar は国民の祝日のみを休日とするカレンダーを返します。
+func NewCalendar() *Calendar {
+	return &Calendar{
+		closedDays:   map[day]string{},
+		annualClosed: map[time.Month]map[int]string{},
+		workingDays:  map[day]bool{},
+	}
+}
+
+// AddClosedDay は t の日付を休業日に追加します。
+func (c *Calendar) AddClosedDay(t time.Time, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d := dayOf(t)
+	delete(c.workingDays, d)
+	c.closedDays[d] = name
+}
+
+// AddAnnualClosedDay は毎年の month 月 d 日を休業日に追加します。
+func (c *Calendar) AddAnnualClosedDay(month time.Month, d int, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.annualClosed[month] == nil {
+		c.annualClosed[month] = map[int]string{}
+	}
+	c.annualClosed[month][d] = name
+}
+
+// SetClosedWeekdays は毎週の休業日とする曜日を設定します。
+func (c *Calendar) SetClosedWeekdays(weekdays ...time.Weekday) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closedWeekdays = slices.Clone(weekdays)
+}
+
+// AddWorkingDay は t の日付を、祝日や休業日であっても営業日とします。
+func (c *Calendar) AddWorkingDay(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	d := dayOf(t)
+	delete(c.closedDays, d)
+	c.workingDays[d] = true
+}
+
+// Lookup は t の日付が祝日または会社の休業日であればその休日を返します。
+// 毎週の休業日とする曜日は休日として返しません。曜日も含めて判定する場合は IsWorkday を使用します。
+func (c *Calendar) Lookup(t time.Time) (Holiday, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lookup(dayOf(t))
+}
+
+func (c *Calendar) lookup(d day) (Holiday, bool) {
+	if c.workingDays[d] {
+		return Holiday{}, false
+	}
+	if name, ok := c.closedDays[d]; ok {
+		return Holiday{Date: d.time(), Name: name, Kind: KindCompany}, true
+	}
+	if h, ok := yearHolidays(d.year)[d]; ok {
+		return h, true
+	}
+	if name, ok := c.annualClosed[d.month][d.day]; ok {
+		return Holiday{Date: d.time(), Name: name, Kind: KindCompany}, true
+	}
+	return Holiday{}, false
+}
+
+// IsHoliday は t の日付が祝日または会社の休業日かどうかを返します。
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	_, ok := c.Lookup(t)
+	return ok
+}
+
+// IsWorkday は t の日付が祝日・会社の休業日・毎週の休業日のいずれでもないかどうかを返します。
+func (c *Calendar) IsWorkday(t time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.isWorkday(dayOf(t))
+}
+
+func (c *Calendar) isWorkday(d day) bool {
+	if c.workingDays[d] {
+		return true
+	}
+	if slices.Contains(c.closedWeekdays, d.time().Weekday()) {
+		return false
+	}
+	_, ok := c.lookup(d)
+	return !ok
+}
+
+// Between は from から to まで(両端を含む)の祝日と会社の休業日を日付の昇順で返します。
+func (c *Calendar) Between(from time.Time, to time.Time) []Holiday {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	holidays := []Holiday{}
+	for d, end := dayOf(from), dayOf(to); !end.before(d); d = d.addDays(1) {
+		if h, ok := c.lookup(d); ok {
+			holidays = append(holidays, h)
+		}
+	}
+	return holidays
+}
+
+// CountWorkdays は from から to まで(両端を含む)の営業日数を返します。
+func (c *Calendar) CountWorkdays(from time.Time, to time.Time) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	n := 0
+	for d, end := dayOf(from), dayOf(to); !end.before(d); d = d.addDays(1) {
+		if c.isWorkday(d) {
+			n++
+		}
+	}
+	return n
+}
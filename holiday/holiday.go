@@ -0,0 +1,309 @@
+// holiday パッケージは「国民の祝日に関する法律」に基づく日本の祝日と、会社独自の休業日を扱います。
+//
+// 祝日は法律の規則から計算するため、祝日の一覧を更新する必要はありません。
+// 春分の日・秋分の日は天文計算による近似式で求めており、1900年から2150年まで計算できます。
+// 日付は time.Time の年月日(時刻とタイムゾーンは無視します)で指定します。
+package holiday
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Kind は休日の種類を表します。
+type Kind string
+
+const (
+	KindNational   Kind = "national"   // 国民の祝日
+	KindSubstitute Kind = "substitute" // 振替休日
+	KindCitizens   Kind = "citizens"   // 国民の休日(祝日に挟まれた平日)
+	KindCompany    Kind = "company"    // 会社独自の休業日
+)
+
+// Holiday は1日分の休日です。
+type Holiday struct {
+	Date time.Time // UTC の0時
+	Name string
+	Kind Kind
+}
+
+// day は時刻とタイムゾーンを除いた年月日です。
+type day struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dayOf(t time.Time) day {
+	return day{t.Year(), t.Month(), t.Day()}
+}
+
+func (d day) time() time.Time {
+	return time.Date(d.year, d.month, d.day, 0, 0, 0, 0, time.UTC)
+}
+
+func (d day) addDays(n int) day {
+	return dayOf(d.time().AddDate(0, 0, n))
+}
+
+// nthWeekday は year 年 month 月の第 n weekday の日を返します。(ハッピーマンデー制度)
+func nthWeekday(year int, month time.Month, n int, weekday time.Weekday) day {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return day{year, month, 1 + offset + (n-1)*7}
+}
+
+// equinoxDay は春分日・秋分日の日を近似式で求めます。計算できない年の場合は0を返します。
+func equinoxDay(year int, base1900, base1980, base2100 float64) int {
+	var base float64
+	leapBase := 1980
+	switch {
+	case year >= 1900 && year <= 1979:
+		base, leapBase = base1900, 1983
+	case year >= 1980 && year <= 2099:
+		base = base1980
+	case year >= 2100 && year <= 2150:
+		base = base2100
+	default:
+		return 0
+	}
+	// 閏年の補正 (year-leapBase)/4 は0方向に切り捨てます
+	return int(base + 0.242194*float64(year-1980) - float64((year-leapBase)/4))
+}
+
+// VernalEquinoxDay は year 年の春分日を返します。計算できない年の場合は false を返します。
+func VernalEquinoxDay(year int) (time.Time, bool) {
+	d := equinoxDay(year, 20.8357, 20.8431, 21.8510)
+	if d == 0 {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.March, d, 0, 0, 0, 0, time.UTC), true
+}
+
+// AutumnalEquinoxDay は year 年の秋分日を返します。計算できない年の場合は false を返します。
+func AutumnalEquinoxDay(year int) (time.Time, bool) {
+	d := equinoxDay(year, 23.2588, 23.2488, 24.2488)
+	if d == 0 {
+		return time.Time{}, false
+	}
+	return time.Date(year, time.September, d, 0, 0, 0, 0, time.UTC), true
+}
+
+// nationalHolidays は year 年の国民の祝日(振替休日・国民の休日を除く)を返します。
+func nationalHolidays(year int) map[day]string {
+	h := map[day]string{}
+	add := func(d day, name string) {
+		h[d] = name
+	}
+	fixed := func(month time.Month, d int, name string) {
+		add(day{year, month, d}, name)
+	}
+
+	if year < 1949 {
+		return h
+	}
+
+	fixed(time.January, 1, "元日")
+	if year <= 1999 {
+		fixed(time.January, 15, "成人の日")
+	} else {
+		add(nthWeekday(year, time.January, 2, time.Monday), "成人の日")
+	}
+	if year >= 1967 {
+		fixed(time.February, 11, "建国記念の日")
+	}
+	if year >= 2020 {
+		fixed(time.February, 23, "天皇誕生日")
+	}
+	if d, ok := VernalEquinoxDay(year); ok {
+		add(dayOf(d), "春分の日")
+	}
+	switch {
+	case year <= 1988:
+		fixed(time.April, 29, "天皇誕生日")
+	case year <= 2006:
+		fixed(time.April, 29, "みどりの日")
+	default:
+		fixed(time.April, 29, "昭和の日")
+	}
+	fixed(time.May, 3, "憲法記念日")
+	if year >= 2007 {
+		fixed(time.May, 4, "みどりの日")
+	}
+	fixed(time.May, 5, "こどもの日")
+	switch {
+	case year == 2020:
+		fixed(time.July, 23, "海の日")
+	case year == 2021:
+		fixed(time.July, 22, "海の日")
+	case year >= 2003:
+		add(nthWeekday(year, time.July, 3, time.Monday), "海の日")
+	case year >= 1996:
+		fixed(time.July, 20, "海の日")
+	}
+	switch {
+	case year == 2020:
+		fixed(time.August, 10, "山の日")
+	case year == 2021:
+		fixed(time.August, 8, "山の日")
+	case year >= 2016:
+		fixed(time.August, 11, "山の日")
+	}
+	switch {
+	case year >= 2003:
+		add(nthWeekday(year, time.September, 3, time.Monday), "敬老の日")
+	case year >= 1966:
+		fixed(time.September, 15, "敬老の日")
+	}
+	if d, ok := AutumnalEquinoxDay(year); ok {
+		add(dayOf(d), "秋分の日")
+	}
+	switch {
+	case year == 2020:
+		fixed(time.July, 24, "スポーツの日")
+	case year == 2021:
+		fixed(time.July, 23, "スポーツの日")
+	case year >= 2020:
+		add(nthWeekday(year, time.October, 2, time.Monday), "スポーツの日")
+	case year >= 2000:
+		add(nthWeekday(year, time.October, 2, time.Monday), "体育の日")
+	case year >= 1966:
+		fixed(time.October, 10, "体育の日")
+	}
+	fixed(time.November, 3, "文化の日")
+	fixed(time.November, 23, "勤労感謝の日")
+	if year >= 1989 && year <= 2018 {
+		fixed(time.December, 23, "天皇誕生日")
+	}
+
+	// 特別法による休日
+	switch year {
+	case 1959:
+		fixed(time.April, 10, "皇太子明仁親王の結婚の儀")
+	case 1989:
+		fixed(time.February, 24, "昭和天皇の大喪の礼")
+	case 1990:
+		fixed(time.November, 12, "即位礼正殿の儀")
+	case 1993:
+		fixed(time.June, 9, "皇太子徳仁親王の結婚の儀")
+	case 2019:
+		fixed(time.May, 1, "天皇の即位の日")
+		fixed(time.October, 22, "即位礼正殿の儀")
+	}
+	return h
+}
+
+var (
+	substituteHolidayStart = day{1973, time.April, 12} // 振替休日の施行日
+	citizensHolidayStart   = day{1985, time.December, 27}
+)
+
+func (d day) before(e day) bool {
+	return d.time().Before(e.time())
+}
+
+// computeYear は year 年の祝日・振替休日・国民の休日を計算します。
+// 年をまたぐ振替休日を考慮するため、前年の祝日も参照します。
+func computeYear(year int) map[day]Holiday {
+	national := nationalHolidays(year)
+	for d, name := range nationalHolidays(year - 1) {
+		if d.month == time.December {
+			national[d] = name
+		}
+	}
+
+	holidays := map[day]Holiday{}
+	for d, name := range national {
+		if d.year == year {
+			holidays[d] = Holiday{Date: d.time(), Name: name, Kind: KindNational}
+		}
+	}
+
+	// 国民の休日: 前日と翌日が国民の祝日である日(日曜日を除く)
+	for d := range national {
+		next := d.addDays(1)
+		if _, ok := national[next]; ok {
+			continue
+		}
+		if _, ok := national[d.addDays(2)]; !ok {
+			continue
+		}
+		if next.year == year && !next.before(citizensHolidayStart) && next.time().Weekday() != time.Sunday {
+			holidays[next] = Holiday{Date: next.time(), Name: "国民の休日", Kind: KindCitizens}
+		}
+	}
+
+	// 振替休日: 国民の祝日が日曜日に当たるときは、その日後においてその日に最も近い国民の祝日でない日
+	// (2006年以前は翌日の月曜日のみ)
+	for d := range national {
+		if d.time().Weekday() != time.Sunday || d.before(substituteHolidayStart) {
+			continue
+		}
+		s := d.addDays(1)
+		if d.year >= 2007 {
+			for {
+				if _, ok := national[s]; !ok {
+					break
+				}
+				s = s.addDays(1)
+			}
+		} else if _, ok := national[s]; ok {
+			continue
+		}
+		if s.year == year {
+			holidays[s] = Holiday{Date: s.time(), Name: "振替休日", Kind: KindSubstitute}
+		}
+	}
+	return holidays
+}
+
+var cache = struct {
+	sync.Mutex
+	years map[int]map[day]Holiday
+}{years: map[int]map[day]Holiday{}}
+
+func yearHolidays(year int) map[day]Holiday {
+	cache.Lock()
+	defer cache.Unlock()
+	h, ok := cache.years[year]
+	if !ok {
+		h = computeYear(year)
+		cache.years[year] = h
+	}
+	return h
+}
+
+// Lookup は t の日付が祝日・振替休日・国民の休日であればその休日を返します。
+func Lookup(t time.Time) (Holiday, bool) {
+	d := dayOf(t)
+	h, ok := yearHolidays(d.year)[d]
+	return h, ok
+}
+
+// IsHoliday は t の日付が祝日・振替休日・国民の休日かどうかを返します。
+func IsHoliday(t time.Time) bool {
+	_, ok := Lookup(t)
+	return ok
+}
+
+// InYear は year 年の祝日・振替休日・国民の休日を日付の昇順で返します。
+func InYear(year int) []Holiday {
+	holidays := []Holiday{}
+	for _, h := range yearHolidays(year) {
+		holidays = append(holidays, h)
+	}
+	sortHolidays(holidays)
+	return holidays
+}
+
+// Between は from から to まで(両端を含む)の祝日・振替休日・国民の休日を日付の昇順で返します。
+func Between(from time.Time, to time.Time) []Holiday {
+	return NewCalendar().Between(from, to)
+}
+
+func sortHolidays(holidays []Holiday) {
+	sort.Slice(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+}
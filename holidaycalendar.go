@@ -0,0 +1,62 @@
+package freee
+
+import (
+	"time"
+
+	"github.com/kurusugawa-computer/freee-go/holiday"
+)
+
+// JapaneseHolidayCalendar は holiday.Calendar を Date で問い合わせるためのアダプタです。
+// HolidayCalendar を実装しているため、ShiftTemplate.Holidays に指定できます。
+type JapaneseHolidayCalendar struct {
+	Calendar *holiday.Calendar // nil の場合は国民の祝日のみを休日とします
+}
+
+// NewJapaneseHolidayCalendar は cal を使用する JapaneseHolidayCalendar を返します。
+// cal に nil を指定すると国民の祝日のみを休日とします。
+func NewJapaneseHolidayCalendar(cal *holiday.Calendar) *JapaneseHolidayCalendar {
+	if cal == nil {
+		cal = holiday.NewCalendar()
+	}
+	return &JapaneseHolidayCalendar{Calendar: cal}
+}
+
+func (c *JapaneseHolidayCalendar) calendar() *holiday.Calendar {
+	if c == nil || c.Calendar == nil {
+		return holiday.NewCalendar()
+	}
+	return c.Calendar
+}
+
+// Lookup は date が祝日または会社の休業日であればその休日を返します。
+func (c *JapaneseHolidayCalendar) Lookup(date Date) (holiday.Holiday, bool) {
+	return c.calendar().Lookup(time.Time(date))
+}
+
+// IsHoliday は date が祝日または会社の休業日かどうかを返します。
+func (c *JapaneseHolidayCalendar) IsHoliday(date Date) bool {
+	return c.calendar().IsHoliday(time.Time(date))
+}
+
+// Between は from から to まで(両端を含む)の祝日と会社の休業日を日付の昇順で返します。
+func (c *JapaneseHolidayCalendar) Between(from Date, to Date) []holiday.Holiday {
+	return c.calendar().Between(time.Time(from), time.Time(to))
+}
+
+// CountWorkdays は from から to まで(両端を含む)の営業日数を返します。
+func (c *JapaneseHolidayCalendar) CountWorkdays(from Date, to Date) int {
+	return c.calendar().CountWorkdays(time.Time(from), time.Time(to))
+}
+
+// DayPattern は date の勤務日の種別を返します。
+// 営業日は NormalDay、legalHoliday の曜日は LegalHoliday、それ以外の休日は PrescribedHoliday です。
+func (c *JapaneseHolidayCalendar) DayPattern(date Date, legalHoliday time.Weekday) DayPattern {
+	switch {
+	case time.Time(date).Weekday() == legalHoliday:
+		return LegalHoliday
+	case c.calendar().IsWorkday(time.Time(date)):
+		return NormalDay
+	default:
+		return PrescribedHoliday
+	}
+}
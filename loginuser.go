@@ -6,15 +6,41 @@ import (
 
 // https://developer.freee.co.jp/reference/hr/reference#operations-tag-ログインユーザー
 
+// CompanyRole は事業所におけるログインユーザーの権限を表します。
+type CompanyRole string
+
+const (
+	CompanyRoleCompanyAdmin CompanyRole = "company_admin" // 管理者
+	CompanyRoleSelfOnly     CompanyRole = "self_only"     // 一般
+	CompanyRoleClerk        CompanyRole = "clerk"         // 事務担当者
+)
+
+func (r CompanyRole) String() string {
+	return string(r)
+}
+
+// IsValid は既知の権限かどうかを返します。
+func (r CompanyRole) IsValid() bool {
+	return isValidEnum(r, CompanyRoleCompanyAdmin, CompanyRoleSelfOnly, CompanyRoleClerk)
+}
+
+func (r CompanyRole) MarshalJSON() ([]byte, error) {
+	return marshalEnum(r)
+}
+
+func (r *CompanyRole) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, r)
+}
+
 type LoginUser struct {
 	ID        int `json:"id"`
 	Companies []struct {
-		ID          int     `json:"id"`
-		Name        string  `json:"name"`
-		Role        string  `json:"role"` // company_admin, self_only, clerk
-		ExternalCID string  `json:"external_cid"`
-		EmployeeID  *int    `json:"employee_id"`
-		DisplayName *string `json:"display_name"`
+		ID          int         `json:"id"`
+		Name        string      `json:"name"`
+		Role        CompanyRole `json:"role"`
+		ExternalCID string      `json:"external_cid"`
+		EmployeeID  *int        `json:"employee_id"`
+		DisplayName *string     `json:"display_name"`
 	} `json:"companies"`
 }
 
@@ -0,0 +1,212 @@
+package freee
+
+import (
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-各種申請
+
+type MonthlyAttendance struct {
+	ID                  int                       `json:"id"`
+	CompanyID           int                       `json:"company_id"`
+	ApplicationNumber   int                       `json:"application_number"`
+	ApplicantID         int                       `json:"applicant_id"`
+	TargetDate          Date                      `json:"target_date"` // 対象年月の1日
+	Comment             string                    `json:"comment"`
+	Status              ApprovalRequestStatus     `json:"status"`
+	IssueDate           Date                      `json:"issue_date"`
+	ApprovalFlowRouteID int                       `json:"approval_flow_route_id"`
+	CurrentStepID       *int                      `json:"current_step_id"`
+	CurrentRound        int                       `json:"current_round"`
+	Approvers           []ApprovalRequestApprover `json:"approvers"`
+	ApprovalFlowLogs    []ApprovalFlowLog         `json:"approval_flow_logs"`
+}
+
+type ListMonthlyAttendancesResult struct {
+	MonthlyAttendances []MonthlyAttendance `json:"monthly_attendances"`
+	TotalCount         int                 `json:"total_count"`
+}
+
+// ListMonthlyAttendances は指定した事業所の月次勤怠締め申請のリストと、条件に一致する申請の総数を返します。
+func (c *Client) ListMonthlyAttendances(companyID int, opts *ListApprovalRequestsOpts) (*ListMonthlyAttendancesResult, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/monthly_attendances"
+	resp, err := c.do(http.MethodGet, u, opts.values(companyID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListMonthlyAttendancesResult
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAllMonthlyAttendances は ListMonthlyAttendances をページングしながら呼び出し、条件に一致する月次勤怠締め申請をすべて返します。
+// opts の Limit と Offset は無視されます。
+func (c *Client) ListAllMonthlyAttendances(companyID int, opts *ListApprovalRequestsOpts) ([]MonthlyAttendance, error) {
+	return listAllApprovalRequests(opts, func(o *ListApprovalRequestsOpts) ([]MonthlyAttendance, int, error) {
+		result, err := c.ListMonthlyAttendances(companyID, o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.MonthlyAttendances, result.TotalCount, nil
+	})
+}
+
+// GetMonthlyAttendance は指定したIDの月次勤怠締め申請を返します。
+func (c *Client) GetMonthlyAttendance(companyID int, monthlyAttendanceID int) (MonthlyAttendance, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/monthly_attendances/" + url.PathEscape(strconv.Itoa(monthlyAttendanceID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return MonthlyAttendance{}, err
+	}
+
+	result := struct {
+		MonthlyAttendance MonthlyAttendance `json:"monthly_attendance"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return MonthlyAttendance{}, err
+	}
+
+	return result.MonthlyAttendance, nil
+}
+
+type MonthlyAttendanceRequest struct {
+	CompanyID           int    `json:"company_id"`
+	TargetDate          Date   `json:"target_date"` // 対象年月の1日
+	Comment             string `json:"comment,omitempty"`
+	ApprovalFlowRouteID int    `json:"approval_flow_route_id"`
+	ApproverID          *int   `json:"approver_id,omitempty"` // 承認者を申請時に指定する経路の場合のみ指定します
+}
+
+// NewMonthlyAttendanceRequest は year 年 month 月の月次勤怠締め申請を作成します。
+func NewMonthlyAttendanceRequest(companyID int, approvalFlowRouteID int, year int, month int) *MonthlyAttendanceRequest {
+	return &MonthlyAttendanceRequest{
+		CompanyID:           companyID,
+		TargetDate:          *NewDate(year, time.Month(month), 1),
+		ApprovalFlowRouteID: approvalFlowRouteID,
+	}
+}
+
+// CreateMonthlyAttendance は月次勤怠締め申請を新規作成します。
+func (c *Client) CreateMonthlyAttendance(request *MonthlyAttendanceRequest) (MonthlyAttendance, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/monthly_attendances"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return MonthlyAttendance{}, err
+	}
+
+	result := struct {
+		MonthlyAttendance MonthlyAttendance `json:"monthly_attendance"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return MonthlyAttendance{}, err
+	}
+
+	return result.MonthlyAttendance, nil
+}
+
+// ActOnMonthlyAttendance は指定した月次勤怠締め申請に対して承認・却下・差戻し・取り消しなどの承認操作を行います。
+func (c *Client) ActOnMonthlyAttendance(monthlyAttendanceID int, request *ApprovalActionRequest) (MonthlyAttendance, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/monthly_attendances/" + url.PathEscape(strconv.Itoa(monthlyAttendanceID)) + "/actions"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return MonthlyAttendance{}, err
+	}
+
+	result := struct {
+		MonthlyAttendance MonthlyAttendance `json:"monthly_attendance"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return MonthlyAttendance{}, err
+	}
+
+	return result.MonthlyAttendance, nil
+}
+
+// MonthlyAttendanceSubmission は従業員の月次勤怠締め申請の提出状況を表します。
+type MonthlyAttendanceSubmission string
+
+const (
+	MonthlyAttendanceNotSubmitted MonthlyAttendanceSubmission = "not_submitted" // 未提出(下書きを含む)
+	MonthlyAttendanceSubmitted    MonthlyAttendanceSubmission = "submitted"     // 申請中
+	MonthlyAttendanceApproved     MonthlyAttendanceSubmission = "approved"      // 承認済み
+	MonthlyAttendanceSentBack     MonthlyAttendanceSubmission = "sent_back"     // 差戻し
+)
+
+type MonthlyAttendanceStatus struct {
+	Employee   CompaniesEmployee
+	Submission MonthlyAttendanceSubmission
+	Request    *MonthlyAttendance // 最新の申請。申請がない場合は nil
+}
+
+// GetMonthlyAttendanceStatuses は指定した事業所の従業員ごとに、year 年 month 月の月次勤怠締め申請の提出状況を返します。
+// 対象の従業員は給与計算対象外の従業員を含む、対象月に在籍している従業員です。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) GetMonthlyAttendanceStatuses(companyID int, year int, month int) ([]MonthlyAttendanceStatus, error) {
+	employees, err := c.ListAllCompaniesEmployees(companyID, &ListAllEmployeesOpts{WithNoPayrollCalculation: true})
+	if err != nil {
+		return nil, err
+	}
+
+	targetDate := NewDate(year, time.Month(month), 1)
+	requests, err := c.ListAllMonthlyAttendances(companyID, &ListApprovalRequestsOpts{
+		StartTargetDate: targetDate,
+		EndTargetDate:   targetDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 同じ申請者の申請が複数ある場合は最新の申請を使用する
+	latest := map[int]*MonthlyAttendance{}
+	for i := range requests {
+		r := &requests[i]
+		if l, ok := latest[r.ApplicantID]; !ok || l.ID < r.ID {
+			latest[r.ApplicantID] = r
+		}
+	}
+
+	firstDay := targetDate.String()
+	lastDay := NewDate(year, time.Month(month)+1, 0).String()
+
+	statuses := []MonthlyAttendanceStatus{}
+	for _, e := range employees {
+		if e.EntryDate > lastDay || (e.RetireDate != nil && *e.RetireDate < firstDay) {
+			continue
+		}
+
+		status := MonthlyAttendanceStatus{
+			Employee:   e,
+			Submission: MonthlyAttendanceNotSubmitted,
+		}
+		if r, ok := latest[e.UserID]; ok {
+			status.Request = r
+			switch r.Status {
+			case ApprovalRequestStatusInProgress:
+				status.Submission = MonthlyAttendanceSubmitted
+			case ApprovalRequestStatusApproved:
+				status.Submission = MonthlyAttendanceApproved
+			case ApprovalRequestStatusFeedback:
+				status.Submission = MonthlyAttendanceSentBack
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].Employee.ID < statuses[j].Employee.ID
+	})
+
+	return statuses, nil
+}
@@ -0,0 +1,190 @@
+package freee
+
+import (
+	"time"
+)
+
+// OvertimeLimits は時間外労働の上限(36協定)の設定です。時間はすべて分単位です。
+type OvertimeLimits struct {
+	MonthlyMins int // 月の時間外労働の上限 (原則: 45時間)
+	AnnualMins  int // 年の時間外労働の上限 (原則: 360時間)
+	// SpecialClause は特別条項付きの36協定を締結しているかどうかを表します。
+	// true の場合、MonthlyMins を超える月は MaxSpecialMonths 回まで、年の上限は SpecialAnnualMins になります。
+	SpecialClause         bool
+	MaxSpecialMonths      int     // MonthlyMins を超えられる月数の上限 (6回)
+	SpecialAnnualMins     int     // 特別条項での年の時間外労働の上限 (720時間)
+	MonthlyTotalMins      int     // 月の時間外労働と休日労働の合計の上限(この値未満) (100時間)
+	MultiMonthAverageMins int     // 2〜6か月平均の時間外労働と休日労働の合計の上限 (80時間)
+	WarningRatio          float64 // 上限に対してこの割合に達した場合に警告します (例: 0.8)
+}
+
+// DefaultOvertimeLimits は労働基準法に定められた時間外労働の上限です。
+var DefaultOvertimeLimits = OvertimeLimits{
+	MonthlyMins:           45 * 60,
+	AnnualMins:            360 * 60,
+	SpecialClause:         false,
+	MaxSpecialMonths:      6,
+	SpecialAnnualMins:     720 * 60,
+	MonthlyTotalMins:      100 * 60,
+	MultiMonthAverageMins: 80 * 60,
+	WarningRatio:          0.8,
+}
+
+// OvertimeRule は時間外労働の上限の種類を表します。
+type OvertimeRule string
+
+const (
+	OvertimeRuleMonthly           OvertimeRule = "monthly"             // 月の時間外労働
+	OvertimeRuleSpecialMonths     OvertimeRule = "special_months"      // 月の上限を超えた月数
+	OvertimeRuleAnnual            OvertimeRule = "annual"              // 年の時間外労働
+	OvertimeRuleMonthlyTotal      OvertimeRule = "monthly_total"       // 月の時間外労働と休日労働の合計
+	OvertimeRuleMultiMonthAverage OvertimeRule = "multi_month_average" // 2〜6か月平均の時間外労働と休日労働の合計
+)
+
+// OvertimeSeverity は上限に対する超過の程度を表します。
+type OvertimeSeverity string
+
+const (
+	OvertimeSeverityWarning   OvertimeSeverity = "warning"   // 上限に近づいている
+	OvertimeSeverityViolation OvertimeSeverity = "violation" // 上限を超えている
+)
+
+// OvertimeMonth は1か月分の時間外労働と休日労働の時間です。
+type OvertimeMonth struct {
+	Year            int
+	Month           int
+	OvertimeMins    int // 法定時間外労働(WorkRecordSummaries.TotalExcessStatutoryWorkMins)
+	HolidayWorkMins int // 法定休日労働(WorkRecordSummaries.TotalHolidayWorkMins)
+}
+
+// TotalMins は時間外労働と休日労働の合計を返します。月100時間・2〜6か月平均80時間の上限の判定に用います。
+func (m OvertimeMonth) TotalMins() int {
+	return m.OvertimeMins + m.HolidayWorkMins
+}
+
+// OvertimeFinding は時間外労働の上限に対する警告または違反です。
+type OvertimeFinding struct {
+	EmployeeID int
+	Year       int // 判定の対象となった月(期間の場合は最終月)
+	Month      int
+	Rule       OvertimeRule
+	Severity   OvertimeSeverity
+	Value      int // 判定に用いた値(分、月数の場合は月数)
+	Limit      int // 上限(分、月数の場合は月数)
+	Months     int // 判定に用いた月数(平均・年の場合)
+}
+
+// CheckOvertimeCompliance は months を古い順に並んだ連続する月として、時間外労働の上限に対する警告と違反を返します。
+// 年の上限は months の全期間で判定するため、通常は直近12か月を指定します。
+func CheckOvertimeCompliance(employeeID int, months []OvertimeMonth, limits OvertimeLimits) []OvertimeFinding {
+	findings := []OvertimeFinding{}
+	add := func(m OvertimeMonth, rule OvertimeRule, value int, limit int, strict bool, n int) {
+		f := OvertimeFinding{EmployeeID: employeeID, Year: m.Year, Month: m.Month, Rule: rule, Value: value, Limit: limit, Months: n}
+		switch {
+		case value > limit || (strict && value >= limit):
+			f.Severity = OvertimeSeverityViolation
+		case limits.WarningRatio > 0 && float64(value) >= float64(limit)*limits.WarningRatio:
+			f.Severity = OvertimeSeverityWarning
+		default:
+			return
+		}
+		findings = append(findings, f)
+	}
+
+	specialMonths := 0
+	annual := 0
+	for i, m := range months {
+		annual += m.OvertimeMins
+
+		if m.OvertimeMins > limits.MonthlyMins && limits.SpecialClause {
+			specialMonths++
+			// 特別条項の範囲内であれば月の上限超過は警告として扱う
+			findings = append(findings, OvertimeFinding{EmployeeID: employeeID, Year: m.Year, Month: m.Month, Rule: OvertimeRuleMonthly, Severity: OvertimeSeverityWarning, Value: m.OvertimeMins, Limit: limits.MonthlyMins, Months: 1})
+		} else {
+			add(m, OvertimeRuleMonthly, m.OvertimeMins, limits.MonthlyMins, false, 1)
+		}
+
+		add(m, OvertimeRuleMonthlyTotal, m.TotalMins(), limits.MonthlyTotalMins, true, 1)
+
+		// 2〜6か月平均は、平均が最も大きい期間についてのみ判定する
+		worstAverage, worstMonths := 0, 0
+		sum := m.TotalMins()
+		for n := 2; n <= 6 && i-n+1 >= 0; n++ {
+			sum += months[i-n+1].TotalMins()
+			if average := sum / n; worstMonths == 0 || average > worstAverage {
+				worstAverage, worstMonths = average, n
+			}
+		}
+		if worstMonths > 0 {
+			add(m, OvertimeRuleMultiMonthAverage, worstAverage, limits.MultiMonthAverageMins, false, worstMonths)
+		}
+	}
+
+	if len(months) > 0 {
+		last := months[len(months)-1]
+		if limits.SpecialClause {
+			add(last, OvertimeRuleSpecialMonths, specialMonths, limits.MaxSpecialMonths, false, len(months))
+			add(last, OvertimeRuleAnnual, annual, limits.SpecialAnnualMins, false, len(months))
+		} else {
+			add(last, OvertimeRuleAnnual, annual, limits.AnnualMins, false, len(months))
+		}
+	}
+
+	return findings
+}
+
+// GetOvertimeMonths は指定した従業員の year 年 month 月までの months か月分の時間外労働と休日労働の時間を古い順に返します。
+func (c *Client) GetOvertimeMonths(companyID int, employeeID int, year int, month int, months int) ([]OvertimeMonth, error) {
+	result := make([]OvertimeMonth, 0, months)
+	last := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	for i := months - 1; i >= 0; i-- {
+		m := last.AddDate(0, -i, 0)
+		summaries, err := c.GetWorkRecordSummaries(companyID, employeeID, m.Year(), int(m.Month()), nil)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, OvertimeMonth{
+			Year:            m.Year(),
+			Month:           int(m.Month()),
+			OvertimeMins:    summaries.TotalExcessStatutoryWorkMins,
+			HolidayWorkMins: summaries.TotalHolidayWorkMins,
+		})
+	}
+	return result, nil
+}
+
+// CheckEmployeeOvertimeCompliance は指定した従業員の year 年 month 月までの直近12か月の勤怠のサマリから、
+// 時間外労働の上限に対する警告と違反を返します。
+func (c *Client) CheckEmployeeOvertimeCompliance(companyID int, employeeID int, year int, month int, limits OvertimeLimits) ([]OvertimeFinding, error) {
+	months, err := c.GetOvertimeMonths(companyID, employeeID, year, month, 12)
+	if err != nil {
+		return nil, err
+	}
+	return CheckOvertimeCompliance(employeeID, months, limits), nil
+}
+
+// CheckCompanyOvertimeCompliance は指定した事業所の直近12か月に在籍していた従業員(給与計算対象外の従業員を含む)ごとに、
+// 時間外労働の上限に対する警告と違反を返します。
+func (c *Client) CheckCompanyOvertimeCompliance(companyID int, year int, month int, limits OvertimeLimits) ([]OvertimeFinding, error) {
+	employees, err := c.ListAllCompaniesEmployees(companyID, &ListAllEmployeesOpts{WithNoPayrollCalculation: true})
+	if err != nil {
+		return nil, err
+	}
+
+	last := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	windowStart := last.AddDate(0, -11, 0).Format("2006-01-02")
+	windowEnd := last.AddDate(0, 1, -1).Format("2006-01-02")
+
+	findings := []OvertimeFinding{}
+	for _, e := range employees {
+		if e.EntryDate > windowEnd || (e.RetireDate != nil && *e.RetireDate < windowStart) {
+			continue
+		}
+		f, err := c.CheckEmployeeOvertimeCompliance(companyID, e.ID, year, month, limits)
+		if err != nil {
+			return nil, err
+		}
+		findings = append(findings, f...)
+	}
+	return findings, nil
+}
@@ -0,0 +1,152 @@
+package freee
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-各種申請
+
+type OvertimeWork struct {
+	ID                  int                       `json:"id"`
+	CompanyID           int                       `json:"company_id"`
+	ApplicationNumber   int                       `json:"application_number"`
+	ApplicantID         int                       `json:"applicant_id"`
+	TargetDate          Date                      `json:"target_date"`
+	StartAt             Time                      `json:"start_at"`
+	EndAt               Time                      `json:"end_at"`
+	IsNextDay           bool                      `json:"is_next_day"` // 終了時刻が翌日かどうか
+	Comment             string                    `json:"comment"`
+	Status              ApprovalRequestStatus     `json:"status"`
+	IssueDate           Date                      `json:"issue_date"`
+	ApprovalFlowRouteID int                       `json:"approval_flow_route_id"`
+	CurrentStepID       *int                      `json:"current_step_id"`
+	CurrentRound        int                       `json:"current_round"`
+	PassedAutoCheck     bool                      `json:"passed_auto_check"`
+	Approvers           []ApprovalRequestApprover `json:"approvers"`
+	ApprovalFlowLogs    []ApprovalFlowLog         `json:"approval_flow_logs"`
+}
+
+type ListOvertimeWorksResult struct {
+	OvertimeWorks []OvertimeWork `json:"overtime_works"`
+	TotalCount    int            `json:"total_count"`
+}
+
+// ListOvertimeWorks は指定した事業所の残業申請のリストと、条件に一致する申請の総数を返します。
+func (c *Client) ListOvertimeWorks(companyID int, opts *ListApprovalRequestsOpts) (*ListOvertimeWorksResult, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/overtime_works"
+	resp, err := c.do(http.MethodGet, u, opts.values(companyID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListOvertimeWorksResult
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetOvertimeWork は指定したIDの残業申請を返します。
+func (c *Client) GetOvertimeWork(companyID int, overtimeWorkID int) (OvertimeWork, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/overtime_works/" + url.PathEscape(strconv.Itoa(overtimeWorkID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return OvertimeWork{}, err
+	}
+
+	result := struct {
+		OvertimeWork OvertimeWork `json:"overtime_work"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return OvertimeWork{}, err
+	}
+
+	return result.OvertimeWork, nil
+}
+
+type OvertimeWorkRequest struct {
+	CompanyID           int    `json:"company_id"`
+	TargetDate          Date   `json:"target_date"`
+	StartAt             Time   `json:"start_at"`
+	EndAt               Time   `json:"end_at"`
+	IsNextDay           bool   `json:"is_next_day,omitempty"` // 終了時刻が翌日の場合に true を指定します
+	Comment             string `json:"comment"`               // 申請理由
+	ApprovalFlowRouteID int    `json:"approval_flow_route_id"`
+	ApproverID          *int   `json:"approver_id,omitempty"` // 承認者を申請時に指定する経路の場合のみ指定します
+}
+
+// CreateOvertimeWork は残業申請を新規作成します。
+func (c *Client) CreateOvertimeWork(request *OvertimeWorkRequest) (OvertimeWork, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/overtime_works"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return OvertimeWork{}, err
+	}
+
+	result := struct {
+		OvertimeWork OvertimeWork `json:"overtime_work"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return OvertimeWork{}, err
+	}
+
+	return result.OvertimeWork, nil
+}
+
+// UpdateOvertimeWork は指定した残業申請を更新します。
+// 注意点
+// - 下書きまたは差戻し状態の申請のみ更新できます。
+func (c *Client) UpdateOvertimeWork(overtimeWorkID int, request *OvertimeWorkRequest) (OvertimeWork, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/overtime_works/" + url.PathEscape(strconv.Itoa(overtimeWorkID))
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return OvertimeWork{}, err
+	}
+
+	result := struct {
+		OvertimeWork OvertimeWork `json:"overtime_work"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return OvertimeWork{}, err
+	}
+
+	return result.OvertimeWork, nil
+}
+
+// DeleteOvertimeWork は指定した残業申請を削除します。
+func (c *Client) DeleteOvertimeWork(companyID int, overtimeWorkID int) error {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/overtime_works/" + url.PathEscape(strconv.Itoa(overtimeWorkID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodDelete, u, q, nil)
+	if err != nil {
+		return err
+	}
+	resp.Close()
+	return nil
+}
+
+// ActOnOvertimeWork は指定した残業申請に対して承認・却下・差戻し・取り下げなどの承認操作を行います。
+func (c *Client) ActOnOvertimeWork(overtimeWorkID int, request *ApprovalActionRequest) (OvertimeWork, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/overtime_works/" + url.PathEscape(strconv.Itoa(overtimeWorkID)) + "/actions"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return OvertimeWork{}, err
+	}
+
+	result := struct {
+		OvertimeWork OvertimeWork `json:"overtime_work"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return OvertimeWork{}, err
+	}
+
+	return result.OvertimeWork, nil
+}
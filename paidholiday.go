@@ -0,0 +1,142 @@
+package freee
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-各種申請
+
+// HolidayType は休暇申請の取得単位を表します。
+type HolidayType string
+
+const (
+	HolidayTypeFull         HolidayType = "full"          // 全日休
+	HolidayTypeMorningOff   HolidayType = "morning_off"   // 午前休
+	HolidayTypeAfternoonOff HolidayType = "afternoon_off" // 午後休
+	HolidayTypeInHours      HolidayType = "in_hours"      // 時間休
+)
+
+type PaidHoliday struct {
+	ID                  int                       `json:"id"`
+	CompanyID           int                       `json:"company_id"`
+	ApplicationNumber   int                       `json:"application_number"`
+	ApplicantID         int                       `json:"applicant_id"`
+	TargetDate          Date                      `json:"target_date"`
+	HolidayType         HolidayType               `json:"holiday_type"`
+	StartAt             *Time                     `json:"start_at"` // 時間休の場合のみ
+	EndAt               *Time                     `json:"end_at"`   // 時間休の場合のみ
+	Comment             string                    `json:"comment"`
+	Status              ApprovalRequestStatus     `json:"status"`
+	IssueDate           Date                      `json:"issue_date"`
+	ApprovalFlowRouteID int                       `json:"approval_flow_route_id"`
+	CurrentStepID       *int                      `json:"current_step_id"`
+	CurrentRound        int                       `json:"current_round"`
+	Approvers           []ApprovalRequestApprover `json:"approvers"`
+	ApprovalFlowLogs    []ApprovalFlowLog         `json:"approval_flow_logs"`
+}
+
+type ListPaidHolidaysResult struct {
+	PaidHolidays []PaidHoliday `json:"paid_holidays"`
+	TotalCount   int           `json:"total_count"`
+}
+
+// ListPaidHolidays は指定した事業所の有給申請のリストと、条件に一致する申請の総数を返します。
+// 申請者・申請ステータス・対象日の期間で絞り込むことができます。
+func (c *Client) ListPaidHolidays(companyID int, opts *ListApprovalRequestsOpts) (*ListPaidHolidaysResult, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/paid_holidays"
+	resp, err := c.do(http.MethodGet, u, opts.values(companyID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListPaidHolidaysResult
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAllPaidHolidays は ListPaidHolidays をページングしながら呼び出し、条件に一致する有給申請をすべて返します。
+// opts の Limit と Offset は無視されます。
+func (c *Client) ListAllPaidHolidays(companyID int, opts *ListApprovalRequestsOpts) ([]PaidHoliday, error) {
+	return listAllApprovalRequests(opts, func(o *ListApprovalRequestsOpts) ([]PaidHoliday, int, error) {
+		result, err := c.ListPaidHolidays(companyID, o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.PaidHolidays, result.TotalCount, nil
+	})
+}
+
+// GetPaidHoliday は指定したIDの有給申請を返します。
+func (c *Client) GetPaidHoliday(companyID int, paidHolidayID int) (PaidHoliday, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/paid_holidays/" + url.PathEscape(strconv.Itoa(paidHolidayID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return PaidHoliday{}, err
+	}
+
+	result := struct {
+		PaidHoliday PaidHoliday `json:"paid_holiday"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return PaidHoliday{}, err
+	}
+
+	return result.PaidHoliday, nil
+}
+
+type PaidHolidayRequest struct {
+	CompanyID           int         `json:"company_id"`
+	TargetDate          Date        `json:"target_date"`
+	HolidayType         HolidayType `json:"holiday_type"`
+	StartAt             *Time       `json:"start_at,omitempty"` // 時間休の場合のみ指定します
+	EndAt               *Time       `json:"end_at,omitempty"`   // 時間休の場合のみ指定します
+	Comment             string      `json:"comment,omitempty"`
+	ApprovalFlowRouteID int         `json:"approval_flow_route_id"`
+	ApproverID          *int        `json:"approver_id,omitempty"` // 承認者を申請時に指定する経路の場合のみ指定します
+}
+
+// CreatePaidHoliday は有給申請を新規作成します。
+// 注意点
+// - 時間休(in_hours)の場合は start_at と end_at が必須です。
+func (c *Client) CreatePaidHoliday(request *PaidHolidayRequest) (PaidHoliday, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/paid_holidays"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return PaidHoliday{}, err
+	}
+
+	result := struct {
+		PaidHoliday PaidHoliday `json:"paid_holiday"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return PaidHoliday{}, err
+	}
+
+	return result.PaidHoliday, nil
+}
+
+// ActOnPaidHoliday は指定した有給申請に対して承認・却下・取り消しなどの承認操作を行います。
+func (c *Client) ActOnPaidHoliday(paidHolidayID int, request *ApprovalActionRequest) (PaidHoliday, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/paid_holidays/" + url.PathEscape(strconv.Itoa(paidHolidayID)) + "/actions"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return PaidHoliday{}, err
+	}
+
+	result := struct {
+		PaidHoliday PaidHoliday `json:"paid_holiday"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return PaidHoliday{}, err
+	}
+
+	return result.PaidHoliday, nil
+}
@@ -0,0 +1,182 @@
+package freee
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-有給休暇
+
+type PaidHolidayGrant struct {
+	ID              int     `json:"id"`
+	EmployeeID      int     `json:"employee_id"`
+	GrantDate       Date    `json:"grant_date"`        // 付与日
+	ExpireDate      Date    `json:"expire_date"`       // 有効期限
+	GrantedDays     float32 `json:"granted_days"`      // 付与日数
+	CarriedOverDays float32 `json:"carried_over_days"` // 前年度からの繰越日数
+	UsedDays        float32 `json:"used_days"`         // この付与分から使用した日数
+	RemainingDays   float32 `json:"remaining_days"`    // この付与分の残日数
+}
+
+// ListPaidHolidayGrants は指定した従業員の有給休暇の付与履歴を付与日の昇順で返します。
+func (c *Client) ListPaidHolidayGrants(companyID int, employeeID int) ([]PaidHolidayGrant, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/paid_holiday_grants"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		PaidHolidayGrants []PaidHolidayGrant `json:"paid_holiday_grants"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.PaidHolidayGrants, nil
+}
+
+type PaidHolidayBalance struct {
+	EmployeeID     int     `json:"employee_id"`
+	Date           Date    `json:"date"`            // 基準日
+	RemainingDays  float32 `json:"remaining_days"`  // 残日数
+	RemainingHours int     `json:"remaining_hours"` // 残時間(時間単位の有給休暇を使用している場合のみ)
+	UsedDays       float32 `json:"used_days"`       // 直近の付与日から基準日までに使用した日数
+	UsedHours      int     `json:"used_hours"`      // 直近の付与日から基準日までに使用した時間
+}
+
+type GetPaidHolidayBalanceOpts struct {
+	Date *Date // 基準日(YYYY-MM-DD)(デフォルト: 当日)
+}
+
+// GetPaidHolidayBalance は指定した従業員の基準日時点の有給休暇の残数を返します。
+func (c *Client) GetPaidHolidayBalance(companyID int, employeeID int, opts *GetPaidHolidayBalanceOpts) (PaidHolidayBalance, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/employees/" + url.PathEscape(strconv.Itoa(employeeID)) + "/paid_holiday_balance"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	if opts != nil {
+		if opts.Date != nil {
+			q.Set("date", opts.Date.String())
+		}
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return PaidHolidayBalance{}, err
+	}
+
+	balance := PaidHolidayBalance{}
+	if err := resp.Parse(&balance); err != nil {
+		return PaidHolidayBalance{}, err
+	}
+
+	return balance, nil
+}
+
+const (
+	// paidHolidayObligationMinGrantedDays は年5日の取得義務の対象となる付与日数の下限です。
+	paidHolidayObligationMinGrantedDays = 10
+	// PaidHolidayObligationDays は年次有給休暇の年間の取得義務日数です。
+	PaidHolidayObligationDays = 5
+)
+
+// PaidHolidayObligationProgress は年5日の有給休暇取得義務に対する従業員の取得状況です。
+type PaidHolidayObligationProgress struct {
+	EmployeeID int
+	// Obligated は基準日時点で取得義務の対象かどうかを表します。
+	// 10日以上付与された付与日から1年以内でない場合は false になり、その他の項目はゼロ値になります。
+	Obligated    bool
+	GrantDate    Date    // 取得義務の起算日となる付与日
+	Deadline     Date    // 取得義務の期限(付与日から1年後の前日)
+	GrantedDays  float32 // 付与日数
+	TakenDays    float32 // 付与日から基準日までに取得した日数(時間単位の取得は含みません)
+	ShortageDays float32 // 義務日数に対して不足している日数
+}
+
+// IsFulfilled は取得義務を満たしているかどうかを返します。
+func (p *PaidHolidayObligationProgress) IsFulfilled() bool {
+	return !p.Obligated || p.ShortageDays <= 0
+}
+
+// GetPaidHolidayObligationProgress は指定した従業員の asOf 時点での年5日の有給休暇取得義務に対する取得状況を返します。
+// 取得日数は付与日から asOf までの日次の勤怠の PaidHoliday を合計して求めます。
+func (c *Client) GetPaidHolidayObligationProgress(companyID int, employeeID int, asOf Date) (*PaidHolidayObligationProgress, error) {
+	grants, err := c.ListPaidHolidayGrants(companyID, employeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &PaidHolidayObligationProgress{EmployeeID: employeeID}
+
+	at := time.Time(asOf)
+	var grant *PaidHolidayGrant
+	for i := range grants {
+		g := &grants[i]
+		start := time.Time(g.GrantDate)
+		if g.GrantedDays < paidHolidayObligationMinGrantedDays || start.After(at) || !at.Before(start.AddDate(1, 0, 0)) {
+			continue
+		}
+		if grant == nil || time.Time(grant.GrantDate).Before(start) {
+			grant = g
+		}
+	}
+	if grant == nil {
+		return progress, nil
+	}
+
+	start := time.Time(grant.GrantDate)
+	taken, err := c.sumPaidHolidays(companyID, employeeID, start, at)
+	if err != nil {
+		return nil, err
+	}
+
+	progress.Obligated = true
+	progress.GrantDate = grant.GrantDate
+	progress.Deadline = Date(start.AddDate(1, 0, -1))
+	progress.GrantedDays = grant.GrantedDays
+	progress.TakenDays = taken
+	if taken < PaidHolidayObligationDays {
+		progress.ShortageDays = PaidHolidayObligationDays - taken
+	}
+	return progress, nil
+}
+
+// ListPaidHolidayObligationProgress は指定した事業所の在籍中の従業員(給与計算対象外の従業員を含む)ごとに、asOf 時点での年5日の有給休暇取得義務に対する取得状況を返します。
+func (c *Client) ListPaidHolidayObligationProgress(companyID int, asOf Date) ([]PaidHolidayObligationProgress, error) {
+	employees, err := c.ListAllCompaniesEmployees(companyID, &ListAllEmployeesOpts{WithNoPayrollCalculation: true})
+	if err != nil {
+		return nil, err
+	}
+
+	day := asOf.String()
+	result := []PaidHolidayObligationProgress{}
+	for _, e := range employees {
+		if e.EntryDate > day || (e.RetireDate != nil && *e.RetireDate < day) {
+			continue
+		}
+		progress, err := c.GetPaidHolidayObligationProgress(companyID, e.ID, asOf)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, *progress)
+	}
+	return result, nil
+}
+
+// sumPaidHolidays は from から to までの日次の勤怠で取得した有給休暇の日数を合計します。
+func (c *Client) sumPaidHolidays(companyID int, employeeID int, from time.Time, to time.Time) (float32, error) {
+	records, err := c.ListWorkRecords(companyID, employeeID, Date(from), Date(to))
+	if err != nil {
+		return 0, err
+	}
+	total := float32(0)
+	for _, r := range records {
+		total += r.PaidHoliday
+	}
+	return total, nil
+}
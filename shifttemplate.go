@@ -0,0 +1,188 @@
+package freee
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ShiftBreak はシフトの休憩時間です。
+type ShiftBreak struct {
+	Start Time
+	End   Time
+}
+
+// ShiftPattern は1日分の勤務時間です。
+// End が Start より前の場合は翌日に終了する勤務として扱います。End と Start が同じ場合は勤務時間のない不正なシフトになります。
+type ShiftPattern struct {
+	Start  Time
+	End    Time
+	Breaks []ShiftBreak
+}
+
+// NewShiftPattern は start から end まで勤務し、breakStart から breakMins 分休憩するシフトを返します。
+// breakMins が0の場合は休憩なしのシフトを返します。
+func NewShiftPattern(start Time, end Time, breakStart Time, breakMins int) *ShiftPattern {
+	p := &ShiftPattern{Start: start, End: end}
+	if breakMins > 0 {
+		p.Breaks = []ShiftBreak{{Start: breakStart, End: Time(time.Time(breakStart).Add(time.Duration(breakMins) * time.Minute))}}
+	}
+	return p
+}
+
+// at は date の日付に勤務開始からの経過として t の時刻を当てはめた日時を返します。
+// 勤務開始より前の時刻は翌日の時刻とみなします。
+func (p *ShiftPattern) at(date Date, t Time) DateTime {
+	d, start, tt := time.Time(date), time.Time(p.Start), time.Time(t)
+	at := time.Date(d.Year(), d.Month(), d.Day(), tt.Hour(), tt.Minute(), tt.Second(), 0, time.UTC)
+	if tt.Hour()*3600+tt.Minute()*60+tt.Second() < start.Hour()*3600+start.Minute()*60+start.Second() {
+		at = at.AddDate(0, 0, 1)
+	}
+	return DateTime(at)
+}
+
+// HolidayCalendar は祝日・休業日を判定します。
+type HolidayCalendar interface {
+	IsHoliday(date Date) bool
+}
+
+// HolidayCalendarFunc は関数を HolidayCalendar として使用するためのアダプタです。
+type HolidayCalendarFunc func(date Date) bool
+
+func (f HolidayCalendarFunc) IsHoliday(date Date) bool {
+	return f(date)
+}
+
+// ShiftException は特定の日のシフトを週ごとのシフトの代わりに適用する例外です。
+type ShiftException struct {
+	Pattern    *ShiftPattern // 勤務時間(nil の場合は休日)
+	DayPattern DayPattern    // 勤務日の種別(空の場合は Pattern の有無から決定します)
+}
+
+// ShiftTemplate は曜日ごとのシフトから月の勤怠を生成するテンプレートです。
+type ShiftTemplate struct {
+	Weekly map[time.Weekday]*ShiftPattern // 曜日ごとのシフト(含まれない曜日は休日)
+	// LegalHolidays は法定休日とする曜日です。休日のうちこの曜日は LegalHoliday、それ以外は PrescribedHoliday になります。
+	LegalHolidays []time.Weekday
+	Exceptions    map[string]ShiftException // 日付(YYYY-MM-DD)ごとの例外
+	Holidays      HolidayCalendar           // 祝日・休業日の判定(例: NewJapaneseHolidayCalendar(nil))(nil の場合は祝日を考慮しません)
+	// WorkOnHolidays が true の場合は、祝日・休業日でも曜日ごとのシフトで勤務します。
+	WorkOnHolidays bool
+}
+
+// SetException は date のシフトを pattern に置き換えます。pattern に nil を指定すると休日になります。
+func (t *ShiftTemplate) SetException(date Date, pattern *ShiftPattern) {
+	if t.Exceptions == nil {
+		t.Exceptions = map[string]ShiftException{}
+	}
+	t.Exceptions[date.String()] = ShiftException{Pattern: pattern}
+}
+
+// ShiftDay はテンプレートから生成した1日分の勤怠の更新内容です。
+type ShiftDay struct {
+	Date    Date
+	Request *PutWorkRecordRequest
+}
+
+// dayOff は休日の勤務日の種別を返します。
+func (t *ShiftTemplate) dayOff(date Date) DayPattern {
+	weekday := time.Time(date).Weekday()
+	for _, w := range t.LegalHolidays {
+		if w == weekday {
+			return LegalHoliday
+		}
+	}
+	return PrescribedHoliday
+}
+
+// Day は date のシフトから勤怠の更新内容を生成します。
+func (t *ShiftTemplate) Day(companyID int, date Date) (ShiftDay, error) {
+	pattern, dayPattern := t.Weekly[time.Time(date).Weekday()], DayPattern("")
+	if e, ok := t.Exceptions[date.String()]; ok {
+		pattern, dayPattern = e.Pattern, e.DayPattern
+	} else if pattern != nil && t.Holidays != nil && !t.WorkOnHolidays && t.Holidays.IsHoliday(date) {
+		pattern = nil
+	}
+	if dayPattern == "" {
+		if pattern != nil {
+			dayPattern = NormalDay
+		} else {
+			dayPattern = t.dayOff(date)
+		}
+	}
+
+	request := &PutWorkRecordRequest{
+		CompanyID:  companyID,
+		DayPattern: &dayPattern,
+	}
+	if pattern != nil {
+		clockInAt, clockOutAt := pattern.at(date, pattern.Start), pattern.at(date, pattern.End)
+		request.ClockInAt, request.ClockOutAt = &clockInAt, &clockOutAt
+		request.BreakRecords = []PutWorkRecordBreakRecord{}
+		for _, b := range pattern.Breaks {
+			request.BreakRecords = append(request.BreakRecords, PutWorkRecordBreakRecord{
+				ClockInAt:  pattern.at(date, b.Start),
+				ClockOutAt: pattern.at(date, b.End),
+			})
+		}
+	}
+	if err := request.Validate(); err != nil {
+		return ShiftDay{}, fmt.Errorf("%s: %w", date.String(), err)
+	}
+	return ShiftDay{Date: date, Request: request}, nil
+}
+
+// Expand は year 年 month 月の各日の勤怠の更新内容を日付の昇順で返します。
+// 対象は暦月の1日から末日までです。
+func (t *ShiftTemplate) Expand(companyID int, year int, month time.Month) ([]ShiftDay, error) {
+	days := []ShiftDay{}
+	for d := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC); d.Month() == month; d = d.AddDate(0, 0, 1) {
+		day, err := t.Day(companyID, Date(d))
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// ShiftApplyResult は1日分の勤怠の更新結果です。
+type ShiftApplyResult struct {
+	Date       Date
+	WorkRecord WorkRecord // 更新後の勤怠(Err が nil の場合のみ)
+	Err        error
+}
+
+type ApplyShiftsOpts struct {
+	// Progress は1日分の更新が終わるたびに呼び出されます。done は処理済みの日数、total は全体の日数です。
+	Progress func(done int, total int, result ShiftApplyResult)
+	// StopOnError が true の場合は、最初に失敗した日で処理を中断します。
+	StopOnError bool
+}
+
+// ApplyShifts は days の勤怠を1日ずつ PutWorkRecord で更新し、日ごとの結果を返します。
+// 失敗した日があっても残りの日の更新を続け、失敗した日のエラーをまとめて返します。
+func (c *Client) ApplyShifts(employeeID int, days []ShiftDay, opts *ApplyShiftsOpts) ([]ShiftApplyResult, error) {
+	o := ApplyShiftsOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	results := make([]ShiftApplyResult, 0, len(days))
+	var errs []error
+	for i, day := range days {
+		result := ShiftApplyResult{Date: day.Date}
+		result.WorkRecord, result.Err = c.PutWorkRecord(employeeID, day.Date, day.Request)
+		results = append(results, result)
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", day.Date.String(), result.Err))
+		}
+		if o.Progress != nil {
+			o.Progress(i+1, len(days), result)
+		}
+		if result.Err != nil && o.StopOnError {
+			break
+		}
+	}
+	return results, errors.Join(errs...)
+}
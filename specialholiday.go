@@ -0,0 +1,314 @@
+package freee
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-各種申請
+
+type SpecialHolidaySetting struct {
+	ID                 int     `json:"id"`
+	CompanyID          int     `json:"company_id"`
+	Name               string  `json:"name"`
+	Paid               bool    `json:"paid"`                // 有給かどうか
+	Days               float32 `json:"days"`                // 付与日数
+	HalfDayAvailable   bool    `json:"half_day_available"`  // 半休で取得できるかどうか
+	HourlyAvailable    bool    `json:"hourly_available"`    // 時間休で取得できるかどうか
+	ApprovalRequired   bool    `json:"approval_required"`   // 取得に申請が必要かどうか
+	AttachmentRequired bool    `json:"attachment_required"` // 申請に添付ファイルが必要かどうか
+}
+
+// Allows は holidayType の単位でこの特別休暇を取得できるかどうかを返します。
+func (s *SpecialHolidaySetting) Allows(holidayType HolidayType) bool {
+	switch holidayType {
+	case HolidayTypeFull:
+		return true
+	case HolidayTypeMorningOff, HolidayTypeAfternoonOff:
+		return s.HalfDayAvailable
+	case HolidayTypeInHours:
+		return s.HourlyAvailable
+	}
+	return false
+}
+
+// ListSpecialHolidaySettings は指定した事業所の特別休暇の設定をリストで返します。
+// WorkRecord.SpecialHolidaySettingID はこの設定のIDを指します。
+func (c *Client) ListSpecialHolidaySettings(companyID int) ([]SpecialHolidaySetting, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/special_holiday_settings"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := struct {
+		SpecialHolidaySettings []SpecialHolidaySetting `json:"special_holiday_settings"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return result.SpecialHolidaySettings, nil
+}
+
+type SpecialHoliday struct {
+	ID                      int                       `json:"id"`
+	CompanyID               int                       `json:"company_id"`
+	ApplicationNumber       int                       `json:"application_number"`
+	ApplicantID             int                       `json:"applicant_id"`
+	SpecialHolidaySettingID int                       `json:"special_holiday_setting_id"`
+	TargetDate              Date                      `json:"target_date"`
+	HolidayType             HolidayType               `json:"holiday_type"`
+	StartAt                 *Time                     `json:"start_at"` // 時間休の場合のみ
+	EndAt                   *Time                     `json:"end_at"`   // 時間休の場合のみ
+	Comment                 string                    `json:"comment"`
+	Status                  ApprovalRequestStatus     `json:"status"`
+	IssueDate               Date                      `json:"issue_date"`
+	ApprovalFlowRouteID     int                       `json:"approval_flow_route_id"`
+	CurrentStepID           *int                      `json:"current_step_id"`
+	CurrentRound            int                       `json:"current_round"`
+	Approvers               []ApprovalRequestApprover `json:"approvers"`
+	ApprovalFlowLogs        []ApprovalFlowLog         `json:"approval_flow_logs"`
+}
+
+type ListSpecialHolidaysResult struct {
+	SpecialHolidays []SpecialHoliday `json:"special_holidays"`
+	TotalCount      int              `json:"total_count"`
+}
+
+// ListSpecialHolidays は指定した事業所の特別休暇申請のリストと、条件に一致する申請の総数を返します。
+func (c *Client) ListSpecialHolidays(companyID int, opts *ListApprovalRequestsOpts) (*ListSpecialHolidaysResult, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/special_holidays"
+	resp, err := c.do(http.MethodGet, u, opts.values(companyID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListSpecialHolidaysResult
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAllSpecialHolidays は ListSpecialHolidays をページングしながら呼び出し、条件に一致する特別休暇申請をすべて返します。
+// opts の Limit と Offset は無視されます。
+func (c *Client) ListAllSpecialHolidays(companyID int, opts *ListApprovalRequestsOpts) ([]SpecialHoliday, error) {
+	return listAllApprovalRequests(opts, func(o *ListApprovalRequestsOpts) ([]SpecialHoliday, int, error) {
+		result, err := c.ListSpecialHolidays(companyID, o)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.SpecialHolidays, result.TotalCount, nil
+	})
+}
+
+// GetSpecialHoliday は指定したIDの特別休暇申請を返します。
+func (c *Client) GetSpecialHoliday(companyID int, specialHolidayID int) (SpecialHoliday, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/special_holidays/" + url.PathEscape(strconv.Itoa(specialHolidayID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return SpecialHoliday{}, err
+	}
+
+	result := struct {
+		SpecialHoliday SpecialHoliday `json:"special_holiday"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return SpecialHoliday{}, err
+	}
+
+	return result.SpecialHoliday, nil
+}
+
+type SpecialHolidayRequest struct {
+	CompanyID               int         `json:"company_id"`
+	SpecialHolidaySettingID int         `json:"special_holiday_setting_id"`
+	TargetDate              Date        `json:"target_date"`
+	HolidayType             HolidayType `json:"holiday_type"`
+	StartAt                 *Time       `json:"start_at,omitempty"` // 時間休の場合のみ指定します
+	EndAt                   *Time       `json:"end_at,omitempty"`   // 時間休の場合のみ指定します
+	Comment                 string      `json:"comment,omitempty"`
+	ApprovalFlowRouteID     int         `json:"approval_flow_route_id"`
+	ApproverID              *int        `json:"approver_id,omitempty"` // 承認者を申請時に指定する経路の場合のみ指定します
+}
+
+// NewFullDaySpecialHolidayRequest は全日休の特別休暇申請を作成します。
+func NewFullDaySpecialHolidayRequest(companyID int, specialHolidaySettingID int, approvalFlowRouteID int, targetDate Date) *SpecialHolidayRequest {
+	return &SpecialHolidayRequest{
+		CompanyID:               companyID,
+		SpecialHolidaySettingID: specialHolidaySettingID,
+		TargetDate:              targetDate,
+		HolidayType:             HolidayTypeFull,
+		ApprovalFlowRouteID:     approvalFlowRouteID,
+	}
+}
+
+// NewHalfDaySpecialHolidayRequest は半休の特別休暇申請を作成します。
+// morning が true の場合は午前休、false の場合は午後休になります。
+func NewHalfDaySpecialHolidayRequest(companyID int, specialHolidaySettingID int, approvalFlowRouteID int, targetDate Date, morning bool) *SpecialHolidayRequest {
+	holidayType := HolidayTypeAfternoonOff
+	if morning {
+		holidayType = HolidayTypeMorningOff
+	}
+	return &SpecialHolidayRequest{
+		CompanyID:               companyID,
+		SpecialHolidaySettingID: specialHolidaySettingID,
+		TargetDate:              targetDate,
+		HolidayType:             holidayType,
+		ApprovalFlowRouteID:     approvalFlowRouteID,
+	}
+}
+
+// NewHourlySpecialHolidayRequest は時間休の特別休暇申請を作成します。
+func NewHourlySpecialHolidayRequest(companyID int, specialHolidaySettingID int, approvalFlowRouteID int, targetDate Date, startAt Time, endAt Time) *SpecialHolidayRequest {
+	return &SpecialHolidayRequest{
+		CompanyID:               companyID,
+		SpecialHolidaySettingID: specialHolidaySettingID,
+		TargetDate:              targetDate,
+		HolidayType:             HolidayTypeInHours,
+		StartAt:                 &startAt,
+		EndAt:                   &endAt,
+		ApprovalFlowRouteID:     approvalFlowRouteID,
+	}
+}
+
+// defaultSpecialHolidayDayMins は時間休を日数に換算する1日の所定労働時間(分)のデフォルト値です。
+const defaultSpecialHolidayDayMins = 8 * 60
+
+// specialHolidayDays は特別休暇の取得日数を返します。時間休は dayMins を1日として日数に換算します。
+func specialHolidayDays(holidayType HolidayType, startAt *Time, endAt *Time, dayMins int) float32 {
+	switch holidayType {
+	case HolidayTypeMorningOff, HolidayTypeAfternoonOff:
+		return 0.5
+	case HolidayTypeInHours:
+		if startAt == nil || endAt == nil {
+			return 0
+		}
+		if dayMins <= 0 {
+			dayMins = defaultSpecialHolidayDayMins
+		}
+		return float32(time.Time(*endAt).Sub(time.Time(*startAt)).Minutes()) / float32(dayMins)
+	}
+	return 1
+}
+
+// Days は申請の取得日数を返します。時間休は dayMins (1日の所定労働時間(分)) を1日として換算します。(0 の場合は8時間)
+func (h *SpecialHoliday) Days(dayMins int) float32 {
+	return specialHolidayDays(h.HolidayType, h.StartAt, h.EndAt, dayMins)
+}
+
+// Days は申請の取得日数を返します。時間休は dayMins (1日の所定労働時間(分)) を1日として換算します。(0 の場合は8時間)
+func (r *SpecialHolidayRequest) Days(dayMins int) float32 {
+	return specialHolidayDays(r.HolidayType, r.StartAt, r.EndAt, dayMins)
+}
+
+// SpecialHolidayUsedDays は holidays のうち settingID の特別休暇の承認済み・申請中の申請の取得日数の合計を返します。
+func SpecialHolidayUsedDays(holidays []SpecialHoliday, settingID int, dayMins int) float32 {
+	used := float32(0)
+	for i := range holidays {
+		h := &holidays[i]
+		if h.SpecialHolidaySettingID != settingID {
+			continue
+		}
+		if h.Status != ApprovalRequestStatusApproved && h.Status != ApprovalRequestStatusInProgress {
+			continue
+		}
+		used += h.Days(dayMins)
+	}
+	return used
+}
+
+// GetSpecialHolidayUsedDays は applicantID のユーザーが from から to までに取得した settingID の特別休暇の日数を、
+// ListAllSpecialHolidays の承認済み・申請中の申請から返します。
+// 時間休は dayMins (1日の所定労働時間(分)) を1日として換算します。(0 の場合は8時間)
+func (c *Client) GetSpecialHolidayUsedDays(companyID int, applicantID int, settingID int, from Date, to Date, dayMins int) (float32, error) {
+	holidays, err := c.ListAllSpecialHolidays(companyID, &ListApprovalRequestsOpts{
+		ApplicantID:     applicantID,
+		StartTargetDate: &from,
+		EndTargetDate:   &to,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return SpecialHolidayUsedDays(holidays, settingID, dayMins), nil
+}
+
+// ValidateFor は申請が setting の特別休暇として取得可能な内容かを検証します。
+// usedDays には setting の特別休暇をすでに取得した日数を指定します。(例: GetSpecialHolidayUsedDays の結果)
+// WorkRecordSummaries.NumSpecialHolidaysUsed はすべての特別休暇の合計のため使用できません。
+// 時間休は dayMins (1日の所定労働時間(分)) を1日として日数に換算します。(0 の場合は8時間)
+func (r *SpecialHolidayRequest) ValidateFor(setting *SpecialHolidaySetting, usedDays float32, dayMins int) error {
+	if setting == nil {
+		return errors.New("special holiday setting is nil")
+	}
+	var errs []error
+	if r.SpecialHolidaySettingID != setting.ID {
+		errs = append(errs, fmt.Errorf("special_holiday_setting_id %d does not match setting %d", r.SpecialHolidaySettingID, setting.ID))
+	}
+	if !setting.Allows(r.HolidayType) {
+		errs = append(errs, fmt.Errorf("%s does not allow holiday_type %s", setting.Name, r.HolidayType))
+	}
+	if r.HolidayType == HolidayTypeInHours {
+		if r.StartAt == nil || r.EndAt == nil {
+			errs = append(errs, errors.New("start_at and end_at are required for holiday_type in_hours"))
+		} else if !time.Time(*r.EndAt).After(time.Time(*r.StartAt)) {
+			errs = append(errs, errors.New("end_at must be after start_at"))
+		}
+	}
+
+	if setting.Days > 0 && usedDays+r.Days(dayMins) > setting.Days {
+		errs = append(errs, fmt.Errorf("%s has only %g days left", setting.Name, setting.Days-usedDays))
+	}
+
+	return errors.Join(errs...)
+}
+
+// CreateSpecialHoliday は特別休暇申請を新規作成します。
+// 注意点
+// - 時間休(in_hours)の場合は start_at と end_at が必須です。
+func (c *Client) CreateSpecialHoliday(request *SpecialHolidayRequest) (SpecialHoliday, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/special_holidays"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return SpecialHoliday{}, err
+	}
+
+	result := struct {
+		SpecialHoliday SpecialHoliday `json:"special_holiday"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return SpecialHoliday{}, err
+	}
+
+	return result.SpecialHoliday, nil
+}
+
+// ActOnSpecialHoliday は指定した特別休暇申請に対して承認・却下・取り消しなどの承認操作を行います。
+func (c *Client) ActOnSpecialHoliday(specialHolidayID int, request *ApprovalActionRequest) (SpecialHoliday, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/special_holidays/" + url.PathEscape(strconv.Itoa(specialHolidayID)) + "/actions"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return SpecialHoliday{}, err
+	}
+
+	result := struct {
+		SpecialHoliday SpecialHoliday `json:"special_holiday"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return SpecialHoliday{}, err
+	}
+
+	return result.SpecialHoliday, nil
+}
@@ -8,13 +8,40 @@ import (
 
 // https://developer.freee.co.jp/reference/hr/reference#operations-tag-タイムレコーダー(打刻)
 
+// TimeClockType は打刻の種別を表します。
+type TimeClockType string
+
+const (
+	TimeClockTypeClockIn    TimeClockType = "clock_in"    // 出勤
+	TimeClockTypeBreakBegin TimeClockType = "break_begin" // 休憩開始
+	TimeClockTypeBreakEnd   TimeClockType = "break_end"   // 休憩終了
+	TimeClockTypeClockOut   TimeClockType = "clock_out"   // 退勤
+)
+
+func (t TimeClockType) String() string {
+	return string(t)
+}
+
+// IsValid は既知の打刻種別かどうかを返します。
+func (t TimeClockType) IsValid() bool {
+	return isValidEnum(t, TimeClockTypeClockIn, TimeClockTypeBreakBegin, TimeClockTypeBreakEnd, TimeClockTypeClockOut)
+}
+
+func (t TimeClockType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(t)
+}
+
+func (t *TimeClockType) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, t)
+}
+
 type TimeClock struct {
-	ID               int    `json:"id"`
-	Date             string `json:"date"`
-	Type             string `json:"type"`
-	Datetime         string `json:"datetime"`
-	OriginalDatetime string `json:"original_datetime"`
-	Note             string `json:"note"`
+	ID               int           `json:"id"`
+	Date             string        `json:"date"`
+	Type             TimeClockType `json:"type"`
+	Datetime         string        `json:"datetime"`
+	OriginalDatetime string        `json:"original_datetime"`
+	Note             string        `json:"note"`
 }
 
 type ListTimeClocksOps struct {
@@ -79,8 +106,8 @@ func (c *Client) GetTimeClock(companyID int, employeeID int, timeClockID int) (T
 }
 
 type AvailableTypes struct {
-	AvailableTypes []string `json:"available_types"`
-	BaseDate       string   `json:"base_date"`
+	AvailableTypes []TimeClockType `json:"available_types"`
+	BaseDate       string          `json:"base_date"`
 }
 
 type GetAvailableTypesOpts struct {
@@ -113,10 +140,11 @@ func (c *Client) GetAvailableTypes(companyID int, employeeID int, opts *GetAvail
 }
 
 type CreateTimeClockRequest struct {
-	CompanyID int       `json:"company_id"`
-	Type      string    `json:"type"`
-	BaseDate  *Date     `json:"base_date,omitempty"`
-	Datetime  *DateTime `json:"datetime,omitempty"`
+	CompanyID int           `json:"company_id"`
+	Type      TimeClockType `json:"type"`
+	BaseDate  *Date         `json:"base_date,omitempty"`
+	Datetime  *DateTime     `json:"datetime,omitempty"`
+	Note      string        `json:"note,omitempty"`
 }
 
 // CreateTimeClock は指定した従業員の打刻情報を登録します。
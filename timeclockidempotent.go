@@ -0,0 +1,148 @@
+package freee
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type CreateTimeClockIdempotentOpts struct {
+	// IdempotencyKey は打刻を識別するためのキーです。打刻の備考(note)に記録され、再送時の照合に使用されます。
+	// 指定しない場合は、打刻種別と打刻日時で照合します。
+	// この場合、照合する時間内に同じ種別の別の打刻(他の端末からの打刻など)があると、その打刻を登録済みとみなして返します。
+	// 確実に照合するには IdempotencyKey を指定してください。
+	IdempotencyKey string
+	MaxRetries     int           // 曖昧な失敗の後に再送する最大回数 (デフォルト: 2)
+	RetryInterval  time.Duration // 再送までの待ち時間 (デフォルト: 1秒)
+	// MatchWindow は Datetime を指定しない打刻を照合する際に、送信時刻の前後で同じ打刻とみなす時間です。(デフォルト: 2分)
+	MatchWindow time.Duration
+}
+
+// idempotencyKeyNote は備考に記録する冪等キーの表記を返します。
+func idempotencyKeyNote(key string) string {
+	return "[idempotency-key:" + key + "]"
+}
+
+// isAmbiguousError は err がリクエストがサーバーで処理されたか判断できない失敗かどうかを返します。
+func isAmbiguousError(err error) bool {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return true
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= 500 || apiErr.StatusCode == http.StatusRequestTimeout || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// CreateTimeClockIdempotent は CreateTimeClock を冪等に実行します。
+// 打刻の登録に失敗した場合は、ListTimeClocks で同じ打刻がすでに登録されていないかを確認し、
+// 登録されていればその打刻を返します。
+// 登録されておらず、タイムアウトなどサーバーで処理されたか判断できない失敗の場合は再送します。
+// 注意点
+// - IdempotencyKey を指定した場合は request.Note にキーが追記されます。
+// - IdempotencyKey を指定しない場合は、無関係の打刻を登録済みとみなすことがあります。
+// - 登録済みかどうかの確認に失敗した場合は再送せず、登録のエラーと確認のエラーを合わせて返します。
+func (c *Client) CreateTimeClockIdempotent(employeeID int, request *CreateTimeClockRequest, opts *CreateTimeClockIdempotentOpts) (TimeClock, error) {
+	o := CreateTimeClockIdempotentOpts{
+		MaxRetries:    2,
+		RetryInterval: time.Second,
+		MatchWindow:   2 * time.Minute,
+	}
+	if opts != nil {
+		o.IdempotencyKey = opts.IdempotencyKey
+		if opts.MaxRetries > 0 {
+			o.MaxRetries = opts.MaxRetries
+		}
+		if opts.RetryInterval > 0 {
+			o.RetryInterval = opts.RetryInterval
+		}
+		if opts.MatchWindow > 0 {
+			o.MatchWindow = opts.MatchWindow
+		}
+	}
+
+	req := *request
+	if o.IdempotencyKey != "" {
+		req.Note = strings.TrimSpace(req.Note + " " + idempotencyKeyNote(o.IdempotencyKey))
+	}
+
+	submittedAt := time.Now()
+	for attempt := 0; ; attempt++ {
+		timeClock, err := c.CreateTimeClock(employeeID, &req)
+		if err == nil {
+			return timeClock, nil
+		}
+
+		found, findErr := c.findSubmittedTimeClock(employeeID, &req, &o, submittedAt)
+		if findErr != nil {
+			// 登録済みか確認できない場合に再送すると二重に打刻するおそれがあるため、再送せずに返します
+			return TimeClock{}, errors.Join(err, fmt.Errorf("failed to find submitted time clock: %w", findErr))
+		}
+		if found != nil {
+			return *found, nil
+		}
+
+		if !isAmbiguousError(err) || attempt >= o.MaxRetries {
+			return TimeClock{}, err
+		}
+		time.Sleep(o.RetryInterval)
+	}
+}
+
+// datetimeInJST は d を freee が解釈する時刻、つまり日本標準時の日時として返します。
+// DateTime はタイムゾーンを持たない日時として送信されるため、d のタイムゾーンは無視します。
+func datetimeInJST(d DateTime) time.Time {
+	t := time.Time(d)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), jst)
+}
+
+// findSubmittedTimeClock は request と同じ打刻が登録済みであればその打刻を返します。
+func (c *Client) findSubmittedTimeClock(employeeID int, request *CreateTimeClockRequest, o *CreateTimeClockIdempotentOpts, submittedAt time.Time) (*TimeClock, error) {
+	target := submittedAt
+	if request.Datetime != nil {
+		target = datetimeInJST(*request.Datetime)
+	}
+	from, to := dateOf(target.AddDate(0, 0, -1)), dateOf(target.AddDate(0, 0, 1))
+	if request.BaseDate != nil {
+		from = *request.BaseDate
+	}
+
+	timeClocks, err := c.listAllTimeClocks(request.CompanyID, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range timeClocks {
+		tc := &timeClocks[i]
+		if tc.Type != request.Type {
+			continue
+		}
+		if o.IdempotencyKey != "" {
+			if strings.Contains(tc.Note, idempotencyKeyNote(o.IdempotencyKey)) {
+				return tc, nil
+			}
+			continue
+		}
+
+		at, err := time.Parse(time.RFC3339, tc.Datetime)
+		if err != nil {
+			continue
+		}
+		if request.Datetime != nil {
+			// 打刻日時は分単位で記録されることがあるため、分に切り捨てた時刻で比較する
+			if at.Truncate(time.Minute).Equal(target.Truncate(time.Minute)) {
+				return tc, nil
+			}
+			continue
+		}
+		if !at.Before(submittedAt.Add(-o.MatchWindow)) && !at.After(time.Now().Add(o.MatchWindow)) {
+			return tc, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,314 @@
+package freee
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+)
+
+// TimeClockState は打刻の状態を表します。
+type TimeClockState string
+
+const (
+	TimeClockStateOffDuty TimeClockState = "off_duty" // 未出勤または退勤済み
+	TimeClockStateWorking TimeClockState = "working"  // 勤務中
+	TimeClockStateOnBreak TimeClockState = "on_break" // 休憩中
+)
+
+// shiftExpiration は出勤打刻から、退勤打刻がなくとも次の出勤打刻ができるようになるまでの時間です。
+const shiftExpiration = 24 * time.Hour
+
+// TimeClockRejectReason は打刻が登録できない理由を表します。
+type TimeClockRejectReason string
+
+const (
+	TimeClockRejectBeforeLastPunch  TimeClockRejectReason = "before_last_punch"  // 直前の打刻より前の時刻
+	TimeClockRejectAlreadyClockedIn TimeClockRejectReason = "already_clocked_in" // 出勤中
+	TimeClockRejectAlreadyWorkedDay TimeClockRejectReason = "already_worked_day" // 同じ打刻日にすでに出勤している
+	TimeClockRejectNotClockedIn     TimeClockRejectReason = "not_clocked_in"     // 出勤していない
+	TimeClockRejectAlreadyOnBreak   TimeClockRejectReason = "already_on_break"   // すでに休憩中
+	TimeClockRejectNotOnBreak       TimeClockRejectReason = "not_on_break"       // 休憩中ではない
+	TimeClockRejectOnBreak          TimeClockRejectReason = "on_break"           // 休憩中のため退勤できない
+	TimeClockRejectShiftExpired     TimeClockRejectReason = "shift_expired"      // 出勤から24時間が経過している
+	TimeClockRejectUnknownType      TimeClockRejectReason = "unknown_type"       // 未知の打刻種別
+)
+
+var timeClockRejectDescriptions = map[TimeClockRejectReason]string{
+	TimeClockRejectBeforeLastPunch:  "直前の打刻より前の時刻には打刻できません。",
+	TimeClockRejectAlreadyClockedIn: "出勤中です。前回の出勤から24時間以内は、退勤してから出勤してください。",
+	TimeClockRejectAlreadyWorkedDay: "この日はすでに出勤しています。",
+	TimeClockRejectNotClockedIn:     "出勤していません。先に出勤してください。",
+	TimeClockRejectAlreadyOnBreak:   "すでに休憩中です。",
+	TimeClockRejectNotOnBreak:       "休憩中ではありません。",
+	TimeClockRejectOnBreak:          "休憩中です。休憩を終了してから退勤してください。",
+	TimeClockRejectShiftExpired:     "出勤から24時間が経過しています。新たに出勤してください。",
+	TimeClockRejectUnknownType:      "不明な打刻種別です。",
+}
+
+// TimeClockRejection は打刻が登録できない理由を表すエラーです。
+type TimeClockRejection struct {
+	Type   TimeClockType
+	State  TimeClockState
+	Reason TimeClockRejectReason
+}
+
+func (e *TimeClockRejection) Error() string {
+	return fmt.Sprintf("cannot punch %s while %s: %s", e.Type, e.State, e.Reason)
+}
+
+// Description は利用者に表示するための打刻できない理由を返します。
+func (e *TimeClockRejection) Description() string {
+	return timeClockRejectDescriptions[e.Reason]
+}
+
+// TimeClockMachine は打刻の履歴を再生し、次に登録できる打刻をサーバーに問い合わせずに判定します。
+// freee の打刻の整合性の規則のうち、次の規則を再現します。
+//   - 出勤 → (休憩開始 → 休憩終了)* → 退勤 の順でのみ打刻できます。
+//   - 前日の出勤時刻から24時間以内の場合、前日の退勤打刻がなければ出勤できません。
+//     24時間経過している場合は、退勤打刻がなくとも出勤できます。
+//   - すでに登録されている退勤打刻よりも後の時刻であれば、退勤打刻を上書き登録できます。
+//   - 打刻が日をまたぐ場合の打刻日(base_date)は出勤した日になります。
+//
+// TimeClockMachine は並行に使用できません。
+type TimeClockMachine struct {
+	state        TimeClockState
+	hasShift     bool
+	baseDate     Date      // 直近の勤務の打刻日
+	clockInAt    time.Time // 直近の勤務の出勤時刻
+	lastClockOut time.Time // 直近の勤務の退勤時刻
+	lastPunch    time.Time
+}
+
+// NewTimeClockMachine は打刻の履歴を時刻順に再生した TimeClockMachine を返します。
+// 履歴は ListTimeClocks の結果をそのまま指定できます。
+// 履歴はサーバーで受け付けられたものとして扱うため、整合性の検証は行いません。
+func NewTimeClockMachine(timeClocks []TimeClock) (*TimeClockMachine, error) {
+	type punch struct {
+		timeClock TimeClock
+		at        time.Time
+	}
+	punches := make([]punch, 0, len(timeClocks))
+	for _, tc := range timeClocks {
+		at, err := time.Parse(time.RFC3339, tc.Datetime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time clock %d: %v", tc.ID, err)
+		}
+		punches = append(punches, punch{tc, at})
+	}
+	sort.SliceStable(punches, func(i, j int) bool {
+		return punches[i].at.Before(punches[j].at)
+	})
+
+	m := &TimeClockMachine{state: TimeClockStateOffDuty}
+	for _, p := range punches {
+		baseDate := dateOf(p.at)
+		if p.timeClock.Date != "" {
+			d, err := time.Parse("2006-01-02", p.timeClock.Date)
+			if err != nil {
+				return nil, fmt.Errorf("invalid time clock %d: %v", p.timeClock.ID, err)
+			}
+			baseDate = Date(d)
+		}
+		m.transition(p.timeClock.Type, p.at, baseDate)
+	}
+	return m, nil
+}
+
+// jst は打刻日の基準となる日本標準時です。タイムゾーンのデータベースがない環境では固定のオフセットを使用します。
+var jst = func() *time.Location {
+	if loc, err := time.LoadLocation("Asia/Tokyo"); err == nil {
+		return loc
+	}
+	return time.FixedZone("JST", 9*60*60)
+}()
+
+// dateOf は t の日本標準時での日付を返します。
+func dateOf(t time.Time) Date {
+	t = t.In(jst)
+	return Date(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+// State は現在の打刻の状態を返します。
+func (m *TimeClockMachine) State() TimeClockState {
+	return m.state
+}
+
+func (m *TimeClockMachine) expired(at time.Time) bool {
+	return m.hasShift && !at.Before(m.clockInAt.Add(shiftExpiration))
+}
+
+// AvailableTypes は at の時刻に登録できる打刻種別と、その打刻の打刻日を返します。
+// GetAvailableTypes をサーバーに問い合わせずに再現したものです。
+func (m *TimeClockMachine) AvailableTypes(at time.Time) AvailableTypes {
+	types := []TimeClockType{}
+	for _, t := range []TimeClockType{TimeClockTypeClockIn, TimeClockTypeBreakBegin, TimeClockTypeBreakEnd, TimeClockTypeClockOut} {
+		if m.reject(t, at) == "" {
+			types = append(types, t)
+		}
+	}
+	baseDate := m.baseDate
+	if !m.hasShift || slices.Contains(types, TimeClockTypeClockIn) {
+		baseDate = dateOf(at)
+	}
+	return AvailableTypes{
+		AvailableTypes: types,
+		BaseDate:       baseDate.String(),
+	}
+}
+
+// Check は at の時刻に typ の打刻が登録できるかを判定します。
+// 登録できない場合は *TimeClockRejection を返します。
+func (m *TimeClockMachine) Check(typ TimeClockType, at time.Time) error {
+	if reason := m.reject(typ, at); reason != "" {
+		return &TimeClockRejection{Type: typ, State: m.state, Reason: reason}
+	}
+	return nil
+}
+
+func (m *TimeClockMachine) reject(typ TimeClockType, at time.Time) TimeClockRejectReason {
+	if !typ.IsValid() {
+		return TimeClockRejectUnknownType
+	}
+	if at.Before(m.lastPunch) {
+		return TimeClockRejectBeforeLastPunch
+	}
+
+	expired := m.expired(at)
+	switch typ {
+	case TimeClockTypeClockIn:
+		if m.state != TimeClockStateOffDuty && !expired {
+			return TimeClockRejectAlreadyClockedIn
+		}
+		if m.hasShift && !time.Time(dateOf(at)).After(time.Time(m.baseDate)) {
+			return TimeClockRejectAlreadyWorkedDay
+		}
+
+	case TimeClockTypeBreakBegin:
+		switch {
+		case !m.hasShift, m.state == TimeClockStateOffDuty:
+			return TimeClockRejectNotClockedIn
+		case expired:
+			return TimeClockRejectShiftExpired
+		case m.state == TimeClockStateOnBreak:
+			return TimeClockRejectAlreadyOnBreak
+		}
+
+	case TimeClockTypeBreakEnd:
+		switch {
+		case m.state != TimeClockStateOnBreak:
+			return TimeClockRejectNotOnBreak
+		case expired:
+			return TimeClockRejectShiftExpired
+		}
+
+	case TimeClockTypeClockOut:
+		switch {
+		case !m.hasShift:
+			return TimeClockRejectNotClockedIn
+		case expired:
+			return TimeClockRejectShiftExpired
+		case m.state == TimeClockStateOnBreak:
+			return TimeClockRejectOnBreak
+		case m.state == TimeClockStateOffDuty && !at.After(m.lastClockOut):
+			return TimeClockRejectBeforeLastPunch
+		}
+	}
+	return ""
+}
+
+// BaseDate は at の時刻に typ の打刻を登録する場合の打刻日を返します。
+func (m *TimeClockMachine) BaseDate(typ TimeClockType, at time.Time) Date {
+	if typ == TimeClockTypeClockIn || !m.hasShift {
+		return dateOf(at)
+	}
+	return m.baseDate
+}
+
+// Apply は typ の打刻を at の時刻に登録したものとして状態を進めます。
+// 登録できない打刻の場合は状態を変えずに *TimeClockRejection を返します。
+func (m *TimeClockMachine) Apply(typ TimeClockType, at time.Time) error {
+	if err := m.Check(typ, at); err != nil {
+		return err
+	}
+	m.transition(typ, at, m.BaseDate(typ, at))
+	return nil
+}
+
+func (m *TimeClockMachine) transition(typ TimeClockType, at time.Time, baseDate Date) {
+	switch typ {
+	case TimeClockTypeClockIn:
+		m.state = TimeClockStateWorking
+		m.hasShift = true
+		m.baseDate = baseDate
+		m.clockInAt = at
+		m.lastClockOut = time.Time{}
+	case TimeClockTypeBreakBegin:
+		m.state = TimeClockStateOnBreak
+	case TimeClockTypeBreakEnd:
+		m.state = TimeClockStateWorking
+	case TimeClockTypeClockOut:
+		m.state = TimeClockStateOffDuty
+		m.lastClockOut = at
+	}
+	if at.After(m.lastPunch) {
+		m.lastPunch = at
+	}
+}
+
+type NewTimeClockRequestOpts struct {
+	// WithDatetime が true の場合は datetime (打刻日時)に at を日本標準時に変換した日時を設定します。
+	// 打刻日時を指定できるのは管理者・事務担当者のみです。false の場合はサーバーが受け付けた時刻で打刻されます。
+	WithDatetime bool
+}
+
+// NewRequest は at の時刻に typ の打刻を登録するための CreateTimeClockRequest を返します。
+// 打刻が日をまたぐ場合は base_date に出勤した日を設定します。
+// 登録できない打刻の場合は *TimeClockRejection を返します。
+func (m *TimeClockMachine) NewRequest(companyID int, typ TimeClockType, at time.Time, opts *NewTimeClockRequestOpts) (*CreateTimeClockRequest, error) {
+	if err := m.Check(typ, at); err != nil {
+		return nil, err
+	}
+	request := &CreateTimeClockRequest{
+		CompanyID: companyID,
+		Type:      typ,
+	}
+	if opts != nil && opts.WithDatetime {
+		datetime := DateTime(at.In(jst))
+		request.Datetime = &datetime
+	}
+	if baseDate := m.BaseDate(typ, at); baseDate != dateOf(at) {
+		request.BaseDate = &baseDate
+	}
+	return request, nil
+}
+
+// GetTimeClockMachine は指定した従業員の at の前日以降の打刻の履歴を再生した TimeClockMachine を返します。
+func (c *Client) GetTimeClockMachine(companyID int, employeeID int, at time.Time) (*TimeClockMachine, error) {
+	timeClocks, err := c.listAllTimeClocks(companyID, employeeID, dateOf(at.Add(-shiftExpiration)), dateOf(at))
+	if err != nil {
+		return nil, err
+	}
+	return NewTimeClockMachine(timeClocks)
+}
+
+// listAllTimeClocks は ListTimeClocks をページングしながら呼び出し、指定した期間の打刻をすべて返します。
+func (c *Client) listAllTimeClocks(companyID int, employeeID int, from Date, to Date) ([]TimeClock, error) {
+	opts := &ListTimeClocksOps{
+		FromDate: &from,
+		ToDate:   &to,
+		Limit:    100,
+	}
+	all := []TimeClock{}
+	for {
+		timeClocks, err := c.ListTimeClocks(companyID, employeeID, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, timeClocks...)
+		if len(timeClocks) < opts.Limit {
+			return all, nil
+		}
+		opts.Offset += len(timeClocks)
+	}
+}
@@ -10,13 +10,18 @@ import (
 type AccessToken = token.TokenInfo
 
 func newTokenManager(clientID string, clientSecret string, accessToken *AccessToken, httpClient *http.Client) *tokenManager {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
 	return &tokenManager{
 		clientID:       clientID,
 		clientSecret:   clientSecret,
 		token:          accessToken,
-		httpClient:     http.DefaultClient,
+		httpClient:     httpClient,
 		mutex:          sync.Mutex{},
+		saveMutex:      sync.Mutex{},
 		onRefreshToken: nil,
+		onRefreshError: nil,
 	}
 }
 
@@ -26,30 +31,77 @@ type tokenManager struct {
 	token          *AccessToken
 	httpClient     *http.Client
 	mutex          sync.Mutex
+	generation     uint64     // トークンを更新した回数
+	saveMutex      sync.Mutex // onRefreshToken の呼び出しを直列化します
+	savedGen       uint64     // onRefreshToken に渡した最新のトークンの generation
 	onRefreshToken func(*AccessToken) error
+	onRefreshError func(error)
 }
 
+// OnRefreshToken はトークンを更新したときに呼び出す関数を設定します。
+// 更新前のリフレッシュトークンは使用できなくなるため、f で更新後のトークンを保存してください。
 func (m *tokenManager) OnRefreshToken(f func(*AccessToken) error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
 	m.onRefreshToken = f
 }
 
+// OnRefreshError はトークンの更新に失敗したときに呼び出す関数を設定します。
+// リフレッシュトークンが失効している場合など、ユーザーに再度の認可を求める必要がある場合の通知に使用します。
+func (m *tokenManager) OnRefreshError(f func(error)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.onRefreshError = f
+}
+
+// current は更新せずに現在のトークンを返します。
+func (m *tokenManager) current() *AccessToken {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.token
+}
+
+// GetAccessToken は有効なアクセストークンを返します。期限が切れている場合は更新します。
+// onRefreshToken と onRefreshError はロックを解放した後に呼び出すため、コールバックからクライアントを使用できます。
 func (m *tokenManager) GetAccessToken() (*AccessToken, error) {
 	m.mutex.Lock()
-	accessToken := m.token
+	if !m.token.IsExpired() {
+		accessToken := m.token
+		m.mutex.Unlock()
+		return accessToken, nil
+	}
 
-	if accessToken.IsExpired() {
-		accessToken, err := token.RefreshAccessToken(m.clientID, m.clientSecret, accessToken.RefreshToken, token.WithHTTPClient(m.httpClient))
-		if err != nil {
-			return nil, err
+	accessToken, err := token.RefreshAccessToken(m.clientID, m.clientSecret, m.token.RefreshToken, token.WithHTTPClient(m.httpClient))
+	if err != nil {
+		onRefreshError := m.onRefreshError
+		m.mutex.Unlock()
+		if onRefreshError != nil {
+			onRefreshError(err)
 		}
-		if m.onRefreshToken != nil {
-			if err := m.onRefreshToken(accessToken); err != nil {
-				return nil, err
-			}
-		}
-		m.token = accessToken
+		return nil, err
 	}
-
+	// 更新前のリフレッシュトークンは失効しているため、onRefreshToken が失敗しても更新後のトークンを使用します。
+	m.token = accessToken
+	m.generation++
+	generation, onRefreshToken := m.generation, m.onRefreshToken
 	m.mutex.Unlock()
+
+	if onRefreshToken != nil {
+		if err := m.saveToken(generation, accessToken, onRefreshToken); err != nil {
+			return nil, err
+		}
+	}
 	return accessToken, nil
 }
+
+// saveToken は onRefreshToken を呼び出します。
+// ロックの解放後に呼び出すため、後から更新したトークンが先に保存されていた場合は古いトークンで上書きしないように呼び出しません。
+func (m *tokenManager) saveToken(generation uint64, accessToken *AccessToken, onRefreshToken func(*AccessToken) error) error {
+	m.saveMutex.Lock()
+	defer m.saveMutex.Unlock()
+	if generation <= m.savedGen {
+		return nil
+	}
+	m.savedGen = generation
+	return onRefreshToken(accessToken)
+}
@@ -3,7 +3,9 @@ package freee
 import (
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"time"
 )
 
 // https://developer.freee.co.jp/reference/hr/reference#operations-tag-勤怠
@@ -13,37 +15,37 @@ type WorkRecord struct {
 		ClockInAt  string `json:"clock_in_at"`
 		ClockOutAt string `json:"clock_out_at"`
 	} `json:"break_records"`
-	ClockInAt                                 *string `json:"clock_in_at"`
-	ClockOutAt                                *string `json:"clock_out_at"`
-	Date                                      string  `json:"date"`
-	DayPattern                                string  `json:"day_pattern"`
-	SchedulePattern                           string  `json:"schedule_pattern"`
-	EarlyLeavingMins                          int     `json:"early_leaving_mins"`
-	HalfPaidHolidayMins                       int     `json:"half_paid_holiday_mins"`
-	HalfSpecialHolidayMins                    int     `json:"half_special_holiday_mins"`
-	HourlyPaidHolidayMins                     int     `json:"hourly_paid_holiday_mins"`
-	HourlySpecialHolidayMins                  int     `json:"hourly_special_holiday_mins"`
-	IsAbsence                                 bool    `json:"is_absence"`
-	IsEditable                                bool    `json:"is_editable"`
-	LatenessMins                              int     `json:"lateness_mins"`
-	NormalWorkClockInAt                       *string `json:"normal_work_clock_in_at"`
-	NormalWorkClockOutAt                      *string `json:"normal_work_clock_out_at"`
-	NormalWorkMins                            int     `json:"normal_work_mins"`
-	Note                                      string  `json:"note"`
-	PaidHoliday                               float32 `json:"paid_holiday"`
-	SpecialHoliday                            float32 `json:"special_holiday"`
-	SpecialHolidaySettingID                   *int    `json:"special_holiday_setting_id"`
-	UseAttendanceDeduction                    bool    `json:"use_attendance_deduction"`
-	UseDefaultWorkPattern                     bool    `json:"use_default_work_pattern"`
-	UseHalfCompensatoryHoliday                bool    `json:"use_half_compensatory_holiday"`
-	TotalOvertimeWorkMins                     int     `json:"total_overtime_work_mins"`
-	TotalHolidayWorkMins                      int     `json:"total_holiday_work_mins"`
-	TotalLatenightWorkMins                    int     `json:"total_latenight_work_mins"`
-	NotAutoCalcWorkTime                       bool    `json:"not_auto_calc_work_time"`
-	TotalExcessStatutoryWorkMins              int     `json:"total_excess_statutory_work_mins"`
-	TotalLatenightExcessStatutoryWorkMins     int     `json:"total_latenight_excess_statutory_work_mins"`
-	TotalOvertimeExceptNormalWorkMins         int     `json:"total_overtime_except_normal_work_mins"`
-	TotalLatenightOvertimeExceptNormalWorkMin int     `json:"total_latenight_overtime_except_normal_work_min"`
+	ClockInAt                                 *string         `json:"clock_in_at"`
+	ClockOutAt                                *string         `json:"clock_out_at"`
+	Date                                      string          `json:"date"`
+	DayPattern                                DayPattern      `json:"day_pattern"`
+	SchedulePattern                           SchedulePattern `json:"schedule_pattern"`
+	EarlyLeavingMins                          int             `json:"early_leaving_mins"`
+	HalfPaidHolidayMins                       int             `json:"half_paid_holiday_mins"`
+	HalfSpecialHolidayMins                    int             `json:"half_special_holiday_mins"`
+	HourlyPaidHolidayMins                     int             `json:"hourly_paid_holiday_mins"`
+	HourlySpecialHolidayMins                  int             `json:"hourly_special_holiday_mins"`
+	IsAbsence                                 bool            `json:"is_absence"`
+	IsEditable                                bool            `json:"is_editable"`
+	LatenessMins                              int             `json:"lateness_mins"`
+	NormalWorkClockInAt                       *string         `json:"normal_work_clock_in_at"`
+	NormalWorkClockOutAt                      *string         `json:"normal_work_clock_out_at"`
+	NormalWorkMins                            int             `json:"normal_work_mins"`
+	Note                                      string          `json:"note"`
+	PaidHoliday                               float32         `json:"paid_holiday"`
+	SpecialHoliday                            float32         `json:"special_holiday"`
+	SpecialHolidaySettingID                   *int            `json:"special_holiday_setting_id"`
+	UseAttendanceDeduction                    bool            `json:"use_attendance_deduction"`
+	UseDefaultWorkPattern                     bool            `json:"use_default_work_pattern"`
+	UseHalfCompensatoryHoliday                bool            `json:"use_half_compensatory_holiday"`
+	TotalOvertimeWorkMins                     int             `json:"total_overtime_work_mins"`
+	TotalHolidayWorkMins                      int             `json:"total_holiday_work_mins"`
+	TotalLatenightWorkMins                    int             `json:"total_latenight_work_mins"`
+	NotAutoCalcWorkTime                       bool            `json:"not_auto_calc_work_time"`
+	TotalExcessStatutoryWorkMins              int             `json:"total_excess_statutory_work_mins"`
+	TotalLatenightExcessStatutoryWorkMins     int             `json:"total_latenight_excess_statutory_work_mins"`
+	TotalOvertimeExceptNormalWorkMins         int             `json:"total_overtime_except_normal_work_mins"`
+	TotalLatenightOvertimeExceptNormalWorkMin int             `json:"total_latenight_overtime_except_normal_work_min"`
 }
 
 // DeleteWorkRecord は指定した従業員の勤怠情報を削除します。
@@ -83,14 +85,61 @@ func (c *Client) GetWorkRecord(companyID int, employeeID int, date Date) (WorkRe
 	return workRecord, nil
 }
 
+// DayPattern は勤務日の種別を表します。
 type DayPattern string
 
 const (
-	NormalDay         DayPattern = "normal_day"
-	PrescribedHoliday DayPattern = "prescribed_holiday"
-	LegalHoliday      DayPattern = "legal_holiday"
+	NormalDay         DayPattern = "normal_day"         // 所定労働日
+	PrescribedHoliday DayPattern = "prescribed_holiday" // 所定休日
+	LegalHoliday      DayPattern = "legal_holiday"      // 法定休日
 )
 
+func (p DayPattern) String() string {
+	return string(p)
+}
+
+// IsValid は既知の勤務日の種別かどうかを返します。
+func (p DayPattern) IsValid() bool {
+	return isValidEnum(p, NormalDay, PrescribedHoliday, LegalHoliday)
+}
+
+func (p DayPattern) MarshalJSON() ([]byte, error) {
+	return marshalEnum(p)
+}
+
+func (p *DayPattern) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, p)
+}
+
+// SchedulePattern は振替・代休・特別休暇などの勤務パターンを表します。
+type SchedulePattern string
+
+const (
+	SchedulePatternNone                    SchedulePattern = ""                          // 通常勤務
+	SchedulePatternSubstituteHolidayWork   SchedulePattern = "substitute_holiday_work"   // 振替出勤
+	SchedulePatternSubstituteHoliday       SchedulePattern = "substitute_holiday"        // 振替休日
+	SchedulePatternCompensatoryHolidayWork SchedulePattern = "compensatory_holiday_work" // 代休出勤
+	SchedulePatternCompensatoryHoliday     SchedulePattern = "compensatory_holiday"      // 代休
+	SchedulePatternSpecialHoliday          SchedulePattern = "special_holiday"           // 特別休暇
+)
+
+func (p SchedulePattern) String() string {
+	return string(p)
+}
+
+// IsValid は既知の勤務パターンかどうかを返します。
+func (p SchedulePattern) IsValid() bool {
+	return isValidEnum(p, SchedulePatternNone, SchedulePatternSubstituteHolidayWork, SchedulePatternSubstituteHoliday, SchedulePatternCompensatoryHolidayWork, SchedulePatternCompensatoryHoliday, SchedulePatternSpecialHoliday)
+}
+
+func (p SchedulePattern) MarshalJSON() ([]byte, error) {
+	return marshalEnum(p)
+}
+
+func (p *SchedulePattern) UnmarshalJSON(b []byte) error {
+	return unmarshalEnum(b, p)
+}
+
 type PutWorkRecordRequest struct {
 	CompanyID                int                        `json:"company_id"`
 	BreakRecords             []PutWorkRecordBreakRecord `json:"break_records,omitempty"`
@@ -176,6 +225,7 @@ type WorkRecordSummaries struct {
 	TotalShortageWorkMins                       *int                      `json:"total_shortage_work_mins"`
 	TotalDeemedPaidExcessStatutoryWorkMins      *int                      `json:"total_deemed_paid_excess_statutory_work_mins"`
 	TotalDeemedPaidOvertimeExceptNormalWorkMins *int                      `json:"total_deemed_paid_overtime_except_normal_work_mins"`
+	AttendanceTags                              []AttendanceTagCount      `json:"attendance_tags"` // 勤怠タグごとの月の合計回数(勤怠タグを使用している場合のみ)
 }
 
 type GetWorkRecordOpts struct {
@@ -208,6 +258,33 @@ func (c *Client) GetWorkRecordSummaries(companyID int, employeeID int, year int,
 	return summaries, nil
 }
 
+// ListWorkRecords は指定した従業員の from から to までの日次の勤怠情報を日付の昇順で返します。
+// 勤怠の締め日によって月次サマリの期間と暦月はずれるため、前後1か月分を含めて GetWorkRecordSummaries で取得し日付で絞り込みます。
+func (c *Client) ListWorkRecords(companyID int, employeeID int, from Date, to Date) ([]WorkRecord, error) {
+	start, end := time.Time(from), time.Time(to)
+	fromDay, toDay := from.String(), to.String()
+
+	seen := map[string]bool{}
+	records := []WorkRecord{}
+	for m := time.Date(start.Year(), start.Month()-1, 1, 0, 0, 0, 0, time.UTC); !m.After(end.AddDate(0, 1, 0)); m = m.AddDate(0, 1, 0) {
+		summaries, err := c.GetWorkRecordSummaries(companyID, employeeID, m.Year(), int(m.Month()), &GetWorkRecordOpts{WorkRecords: true})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range summaries.WorkRecords {
+			if seen[r.Date] || r.Date < fromDay || r.Date > toDay {
+				continue
+			}
+			seen[r.Date] = true
+			records = append(records, r)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Date < records[j].Date
+	})
+	return records, nil
+}
+
 // 値が設定されなかった場合は自動的に0が設定されます
 type PutWorkRecordSummariesRequest struct {
 	CompanyID                                      int     `json:"company_id"`                                                   // 事業所ID（必須）
@@ -0,0 +1,331 @@
+package freee
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// PunchedWorkRecord は打刻から導出した1日分の出退勤・休憩の時刻です。
+type PunchedWorkRecord struct {
+	Date       Date
+	ClockInAt  *time.Time
+	ClockOutAt *time.Time
+	Breaks     []PunchedBreak
+}
+
+type PunchedBreak struct {
+	BeginAt time.Time
+	EndAt   time.Time
+}
+
+// IsComplete は出勤・退勤の打刻が揃っているかどうかを返します。
+// 打刻が揃っていない日は勤怠に反映できません。
+func (r *PunchedWorkRecord) IsComplete() bool {
+	return r.ClockInAt != nil && r.ClockOutAt != nil
+}
+
+// DeriveWorkRecordsFromTimeClocks は打刻を打刻日ごとにまとめ、出退勤・休憩の時刻を日付の昇順で返します。
+// 出勤は最初の出勤打刻、退勤は最後の退勤打刻を採用し、休憩は休憩開始と直後の休憩終了を組にします。
+// 終了の打刻がない休憩は無視されます。
+func DeriveWorkRecordsFromTimeClocks(timeClocks []TimeClock) ([]PunchedWorkRecord, error) {
+	sorted := make([]TimeClock, len(timeClocks))
+	copy(sorted, timeClocks)
+	times := make(map[int]time.Time, len(sorted))
+	for _, tc := range sorted {
+		at, err := time.Parse(time.RFC3339, tc.Datetime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time clock %d: %v", tc.ID, err)
+		}
+		times[tc.ID] = at
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return times[sorted[i].ID].Before(times[sorted[j].ID])
+	})
+
+	records := []PunchedWorkRecord{}
+	index := map[string]int{}
+	breakBegins := map[string]*time.Time{}
+	for _, tc := range sorted {
+		i, ok := index[tc.Date]
+		if !ok {
+			d, err := time.Parse("2006-01-02", tc.Date)
+			if err != nil {
+				return nil, fmt.Errorf("invalid time clock %d: %v", tc.ID, err)
+			}
+			i = len(records)
+			index[tc.Date] = i
+			records = append(records, PunchedWorkRecord{Date: Date(d)})
+		}
+		r := &records[i]
+		at := times[tc.ID]
+		switch tc.Type {
+		case TimeClockTypeClockIn:
+			if r.ClockInAt == nil {
+				r.ClockInAt = &at
+			}
+		case TimeClockTypeClockOut:
+			r.ClockOutAt = &at
+		case TimeClockTypeBreakBegin:
+			breakBegins[tc.Date] = &at
+		case TimeClockTypeBreakEnd:
+			if begin := breakBegins[tc.Date]; begin != nil {
+				r.Breaks = append(r.Breaks, PunchedBreak{BeginAt: *begin, EndAt: at})
+				breakBegins[tc.Date] = nil
+			}
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return time.Time(records[i].Date).Before(time.Time(records[j].Date))
+	})
+	return records, nil
+}
+
+// WorkRecordChange は勤怠の項目ごとの差分です。
+type WorkRecordChange struct {
+	Field    string // 差分のある項目の JSON のパス(例: break_records[0].clock_in_at)
+	Current  string // 現在の勤怠の値(未設定の場合は空文字列)
+	Expected string // 打刻から導出した値(未設定の場合は空文字列)
+}
+
+func (c WorkRecordChange) String() string {
+	return fmt.Sprintf("%s: %q -> %q", c.Field, c.Current, c.Expected)
+}
+
+// WorkRecordReconcileStatus は日ごとの突き合わせの結果を表します。
+type WorkRecordReconcileStatus string
+
+const (
+	WorkRecordReconcileUnchanged   WorkRecordReconcileStatus = "unchanged"    // 勤怠と打刻が一致している
+	WorkRecordReconcileUpdated     WorkRecordReconcileStatus = "updated"      // 勤怠を打刻に合わせて更新した
+	WorkRecordReconcileWouldUpdate WorkRecordReconcileStatus = "would_update" // DryRun のため更新しなかった
+	WorkRecordReconcileNotEditable WorkRecordReconcileStatus = "not_editable" // 勤怠が編集できないため更新しなかった
+	WorkRecordReconcileIncomplete  WorkRecordReconcileStatus = "incomplete"   // 出勤・退勤の打刻が揃っていないため更新しなかった
+	WorkRecordReconcileFailed      WorkRecordReconcileStatus = "failed"       // 勤怠の取得または更新に失敗した
+)
+
+// WorkRecordReconcileResult は1日分の突き合わせの結果です。
+type WorkRecordReconcileResult struct {
+	Date    Date
+	Status  WorkRecordReconcileStatus
+	Changes []WorkRecordChange
+	Err     error // Status が WorkRecordReconcileFailed の場合のエラー
+}
+
+// WorkRecordReconcileReport は従業員ごとの突き合わせの結果です。
+type WorkRecordReconcileReport struct {
+	EmployeeID int
+	DryRun     bool
+	Results    []WorkRecordReconcileResult
+}
+
+// Changed は勤怠との差分があった日の結果を返します。
+func (r *WorkRecordReconcileReport) Changed() []WorkRecordReconcileResult {
+	changed := []WorkRecordReconcileResult{}
+	for _, result := range r.Results {
+		if len(result.Changes) > 0 {
+			changed = append(changed, result)
+		}
+	}
+	return changed
+}
+
+// Failed は勤怠の取得または更新に失敗した日の結果を返します。
+func (r *WorkRecordReconcileReport) Failed() []WorkRecordReconcileResult {
+	failed := []WorkRecordReconcileResult{}
+	for _, result := range r.Results {
+		if result.Status == WorkRecordReconcileFailed {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+type ReconcileWorkRecordsOpts struct {
+	DryRun    bool          // true の場合は差分の検出のみを行い、勤怠を更新しません
+	Tolerance time.Duration // 勤怠と打刻の時刻のずれを許容する時間 (デフォルト: 0)
+}
+
+// diffWorkRecord は現在の勤怠と打刻から導出した時刻の差分を返します。
+func diffWorkRecord(current *WorkRecord, expected *PunchedWorkRecord, tolerance time.Duration) ([]WorkRecordChange, error) {
+	changes := []WorkRecordChange{}
+	compare := func(field string, current string, expected *time.Time) error {
+		want := ""
+		if expected != nil {
+			want = expected.Format(time.RFC3339)
+		}
+		if current == "" || expected == nil {
+			if current != want {
+				changes = append(changes, WorkRecordChange{Field: field, Current: current, Expected: want})
+			}
+			return nil
+		}
+		got, err := time.Parse(time.RFC3339, current)
+		if err != nil {
+			return fmt.Errorf("invalid work record %s: %v", current, err)
+		}
+		if absDuration(got.Sub(*expected)) > tolerance {
+			changes = append(changes, WorkRecordChange{Field: field, Current: current, Expected: want})
+		}
+		return nil
+	}
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+
+	if err := compare("clock_in_at", value(current.ClockInAt), expected.ClockInAt); err != nil {
+		return nil, err
+	}
+	if err := compare("clock_out_at", value(current.ClockOutAt), expected.ClockOutAt); err != nil {
+		return nil, err
+	}
+	n := max(len(current.BreakRecords), len(expected.Breaks))
+	for i := 0; i < n; i++ {
+		var got struct{ ClockInAt, ClockOutAt string }
+		if i < len(current.BreakRecords) {
+			got.ClockInAt, got.ClockOutAt = current.BreakRecords[i].ClockInAt, current.BreakRecords[i].ClockOutAt
+		}
+		var beginAt, endAt *time.Time
+		if i < len(expected.Breaks) {
+			beginAt, endAt = &expected.Breaks[i].BeginAt, &expected.Breaks[i].EndAt
+		}
+		if err := compare(fmt.Sprintf("break_records[%d].clock_in_at", i), got.ClockInAt, beginAt); err != nil {
+			return nil, err
+		}
+		if err := compare(fmt.Sprintf("break_records[%d].clock_out_at", i), got.ClockOutAt, endAt); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// newReconcileRequest は打刻から導出した時刻で勤怠を更新するリクエストを返します。
+// PutWorkRecord は勤怠の全体を置き換えるため、出退勤・休憩の時刻以外の項目は現在の勤怠の値を引き継ぎます。
+func newReconcileRequest(companyID int, current *WorkRecord, expected *PunchedWorkRecord) (*PutWorkRecordRequest, error) {
+	clockInAt, clockOutAt := DateTime(*expected.ClockInAt), DateTime(*expected.ClockOutAt)
+	paidHoliday, specialHoliday := int(current.PaidHoliday), int(current.SpecialHoliday)
+	request := &PutWorkRecordRequest{
+		CompanyID:                companyID,
+		ClockInAt:                &clockInAt,
+		ClockOutAt:               &clockOutAt,
+		BreakRecords:             []PutWorkRecordBreakRecord{},
+		EarlyLeavingMins:         &current.EarlyLeavingMins,
+		IsAbsence:                &current.IsAbsence,
+		LatenessMins:             &current.LatenessMins,
+		NormalWorkMins:           &current.NormalWorkMins,
+		Note:                     &current.Note,
+		PaidHoliday:              &paidHoliday,
+		HalfPaidHolidayMins:      &current.HalfPaidHolidayMins,
+		HourlyPaidHolidayMins:    &current.HourlyPaidHolidayMins,
+		SpecialHoliday:           &specialHoliday,
+		SpecialHolidaySettingID:  current.SpecialHolidaySettingID,
+		HalfSpecialHolidayMins:   &current.HalfSpecialHolidayMins,
+		HourlySpecialHolidayMins: &current.HourlySpecialHolidayMins,
+		UseAttendanceDeduction:   &current.UseAttendanceDeduction,
+		UseDefaultWorkPattern:    &current.UseDefaultWorkPattern,
+	}
+	if current.DayPattern != "" {
+		dayPattern := current.DayPattern
+		request.DayPattern = &dayPattern
+	}
+	dateTimeOf := func(field string, s *string) (*DateTime, error) {
+		t, err := parseWorkRecordTime(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", field, err)
+		}
+		if t == nil {
+			return nil, nil
+		}
+		d := DateTime(*t)
+		return &d, nil
+	}
+	var err error
+	if request.NormalWorkClockInAt, err = dateTimeOf("normal_work_clock_in_at", current.NormalWorkClockInAt); err != nil {
+		return nil, err
+	}
+	if request.NormalWorkClockOutAt, err = dateTimeOf("normal_work_clock_out_at", current.NormalWorkClockOutAt); err != nil {
+		return nil, err
+	}
+	for _, b := range expected.Breaks {
+		request.BreakRecords = append(request.BreakRecords, PutWorkRecordBreakRecord{
+			ClockInAt:  DateTime(b.BeginAt),
+			ClockOutAt: DateTime(b.EndAt),
+		})
+	}
+	return request, nil
+}
+
+// ReconcileWorkRecords は指定した従業員の from から to までの打刻から日ごとの出退勤・休憩の時刻を導出し、
+// GetWorkRecord で取得した勤怠と突き合わせて差分のある勤怠を PutWorkRecord で更新します。
+// 打刻のない日は対象外です。日ごとの取得・更新の失敗は結果の Err に記録し、残りの日の処理を続けます。
+// 注意点
+// - IsEditable が false の勤怠と、出勤・退勤の打刻が揃っていない日は更新しません。
+// - 更新する項目は出退勤・休憩の時刻のみで、勤務日の種別や休暇・備考などの他の項目は現在の勤怠の値を引き継ぎます。
+func (c *Client) ReconcileWorkRecords(companyID int, employeeID int, from Date, to Date, opts *ReconcileWorkRecordsOpts) (*WorkRecordReconcileReport, error) {
+	o := ReconcileWorkRecordsOpts{}
+	if opts != nil {
+		o = *opts
+	}
+
+	timeClocks, err := c.listAllTimeClocks(companyID, employeeID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := DeriveWorkRecordsFromTimeClocks(timeClocks)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &WorkRecordReconcileReport{
+		EmployeeID: employeeID,
+		DryRun:     o.DryRun,
+		Results:    []WorkRecordReconcileResult{},
+	}
+	for i := range expected {
+		e := &expected[i]
+		result := WorkRecordReconcileResult{Date: e.Date}
+
+		current, err := c.GetWorkRecord(companyID, employeeID, e.Date)
+		if err != nil {
+			result.Status, result.Err = WorkRecordReconcileFailed, err
+			report.Results = append(report.Results, result)
+			continue
+		}
+		result.Changes, err = diffWorkRecord(&current, e, o.Tolerance)
+		if err != nil {
+			result.Status, result.Err = WorkRecordReconcileFailed, err
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		switch {
+		case len(result.Changes) == 0:
+			result.Status = WorkRecordReconcileUnchanged
+		case !e.IsComplete():
+			result.Status = WorkRecordReconcileIncomplete
+		case !current.IsEditable:
+			result.Status = WorkRecordReconcileNotEditable
+		case o.DryRun:
+			result.Status = WorkRecordReconcileWouldUpdate
+		default:
+			request, err := newReconcileRequest(companyID, &current, e)
+			if err == nil {
+				err = request.Validate()
+			}
+			if err != nil {
+				result.Status, result.Err = WorkRecordReconcileFailed, err
+				break
+			}
+			if _, err := c.PutWorkRecord(employeeID, e.Date, request); err != nil {
+				result.Status, result.Err = WorkRecordReconcileFailed, err
+				break
+			}
+			result.Status = WorkRecordReconcileUpdated
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report, nil
+}
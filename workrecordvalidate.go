@@ -0,0 +1,193 @@
+package freee
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorkRecordViolationReason は勤怠の更新内容が満たしていない規則を表します。
+type WorkRecordViolationReason string
+
+const (
+	WorkRecordViolationRequired         WorkRecordViolationReason = "required"           // 必要な項目が指定されていない
+	WorkRecordViolationNegative         WorkRecordViolationReason = "negative"           // 負の値が指定されている
+	WorkRecordViolationEndBeforeStart   WorkRecordViolationReason = "end_before_start"   // 終了時刻が開始時刻以前
+	WorkRecordViolationBreakOutsideWork WorkRecordViolationReason = "break_outside_work" // 休憩が勤務時間外
+	WorkRecordViolationBreakOverlap     WorkRecordViolationReason = "break_overlap"      // 休憩が他の休憩と重複している
+	WorkRecordViolationHolidayWithWork  WorkRecordViolationReason = "holiday_with_work"  // 全日の休暇・欠勤に勤務時間が指定されている
+)
+
+// WorkRecordViolation は勤怠の更新内容の項目ごとの違反です。
+type WorkRecordViolation struct {
+	Field   string // 違反のある項目の JSON のパス(例: break_records[1].clock_in_at)
+	Reason  WorkRecordViolationReason
+	Message string
+}
+
+func (v WorkRecordViolation) String() string {
+	return v.Field + ": " + v.Message
+}
+
+// WorkRecordValidationError は PutWorkRecordRequest.Validate で見つかった違反をまとめたエラーです。
+type WorkRecordValidationError struct {
+	Violations []WorkRecordViolation
+}
+
+func (e *WorkRecordValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, v.String())
+	}
+	return "invalid work record: " + strings.Join(messages, "; ")
+}
+
+// Validate は freee が受け付けない勤怠の更新内容をサーバーに送信せずに検出します。
+// 違反がある場合は *WorkRecordValidationError を返します。
+// 日をまたぐ勤務は、ClockOutAt に翌日の日時を指定したものとして扱います。
+func (r *PutWorkRecordRequest) Validate() error {
+	var violations []WorkRecordViolation
+	violate := func(field string, reason WorkRecordViolationReason, format string, args ...any) {
+		violations = append(violations, WorkRecordViolation{Field: field, Reason: reason, Message: fmt.Sprintf(format, args...)})
+	}
+
+	for field, v := range map[string]*int{
+		"early_leaving_mins":          r.EarlyLeavingMins,
+		"lateness_mins":               r.LatenessMins,
+		"normal_work_mins":            r.NormalWorkMins,
+		"half_paid_holiday_mins":      r.HalfPaidHolidayMins,
+		"hourly_paid_holiday_mins":    r.HourlyPaidHolidayMins,
+		"half_special_holiday_mins":   r.HalfSpecialHolidayMins,
+		"hourly_special_holiday_mins": r.HourlySpecialHolidayMins,
+	} {
+		if v != nil && *v < 0 {
+			violate(field, WorkRecordViolationNegative, "must not be negative")
+		}
+	}
+
+	var workStart, workEnd time.Time
+	switch {
+	case r.ClockInAt != nil && r.ClockOutAt != nil:
+		workStart, workEnd = time.Time(*r.ClockInAt), time.Time(*r.ClockOutAt)
+		if !workEnd.After(workStart) {
+			violate("clock_out_at", WorkRecordViolationEndBeforeStart, "clock out %s must be after clock in %s", r.ClockOutAt, r.ClockInAt)
+		}
+	case r.ClockInAt != nil:
+		violate("clock_out_at", WorkRecordViolationRequired, "required when clock_in_at is set")
+	case r.ClockOutAt != nil:
+		violate("clock_in_at", WorkRecordViolationRequired, "required when clock_out_at is set")
+	}
+	worked := r.ClockInAt != nil || r.ClockOutAt != nil
+
+	if r.NormalWorkClockInAt != nil && r.NormalWorkClockOutAt != nil && !time.Time(*r.NormalWorkClockOutAt).After(time.Time(*r.NormalWorkClockInAt)) {
+		violate("normal_work_clock_out_at", WorkRecordViolationEndBeforeStart, "normal work clock out %s must be after normal work clock in %s", r.NormalWorkClockOutAt, r.NormalWorkClockInAt)
+	}
+
+	type span struct {
+		index      int
+		start, end time.Time
+	}
+	breaks := []span{}
+	for i, b := range r.BreakRecords {
+		field := fmt.Sprintf("break_records[%d]", i)
+		start, end := time.Time(b.ClockInAt), time.Time(b.ClockOutAt)
+		if start.IsZero() {
+			violate(field+".clock_in_at", WorkRecordViolationRequired, "required")
+		}
+		if end.IsZero() {
+			violate(field+".clock_out_at", WorkRecordViolationRequired, "required")
+		}
+		if start.IsZero() || end.IsZero() {
+			continue
+		}
+		if !end.After(start) {
+			violate(field+".clock_out_at", WorkRecordViolationEndBeforeStart, "break end %s must be after break start %s", &b.ClockOutAt, &b.ClockInAt)
+			continue
+		}
+		if !worked {
+			violate(field, WorkRecordViolationBreakOutsideWork, "break requires clock_in_at and clock_out_at")
+		} else if !workStart.IsZero() && !workEnd.IsZero() && (start.Before(workStart) || end.After(workEnd)) {
+			violate(field, WorkRecordViolationBreakOutsideWork, "break %s - %s must be within work %s - %s", &b.ClockInAt, &b.ClockOutAt, r.ClockInAt, r.ClockOutAt)
+		}
+		breaks = append(breaks, span{i, start, end})
+	}
+	sort.Slice(breaks, func(i, j int) bool {
+		return breaks[i].start.Before(breaks[j].start)
+	})
+	// 開始順に並べた休憩を、それまでで最も遅く終わる休憩と比較します。
+	// 直前の休憩だけと比較すると、長い休憩の中に含まれる複数の休憩の重複を見落とします。
+	maxIdx := 0
+	for i := 1; i < len(breaks); i++ {
+		if breaks[i].start.Before(breaks[maxIdx].end) {
+			violate(fmt.Sprintf("break_records[%d]", breaks[i].index), WorkRecordViolationBreakOverlap, "break overlaps break_records[%d]", breaks[maxIdx].index)
+		}
+		if breaks[i].end.After(breaks[maxIdx].end) {
+			maxIdx = i
+		}
+	}
+
+	if worked || len(r.BreakRecords) > 0 {
+		if r.IsAbsence != nil && *r.IsAbsence {
+			violate("is_absence", WorkRecordViolationHolidayWithWork, "absence cannot have work time")
+		}
+		if r.PaidHoliday != nil && *r.PaidHoliday >= 1 {
+			violate("paid_holiday", WorkRecordViolationHolidayWithWork, "full day paid holiday cannot have work time")
+		}
+		if r.SpecialHoliday != nil && *r.SpecialHoliday >= 1 {
+			violate("special_holiday", WorkRecordViolationHolidayWithWork, "full day special holiday cannot have work time")
+		}
+	}
+
+	specialHoliday := (r.SpecialHoliday != nil && *r.SpecialHoliday > 0) ||
+		(r.HalfSpecialHolidayMins != nil && *r.HalfSpecialHolidayMins > 0) ||
+		(r.HourlySpecialHolidayMins != nil && *r.HourlySpecialHolidayMins > 0)
+	if specialHoliday && r.SpecialHolidaySettingID == nil {
+		violate("special_holiday_setting_id", WorkRecordViolationRequired, "required when a special holiday is set")
+	}
+
+	if len(violations) > 0 {
+		sort.SliceStable(violations, func(i, j int) bool {
+			return lessViolationField(violations[i].Field, violations[j].Field)
+		})
+		return &WorkRecordValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// lessViolationField は項目のパスを比較します。配列の添字は数値として比較し、break_records[2] を break_records[10] より前にします。
+func lessViolationField(a string, b string) bool {
+	for a != "" && b != "" {
+		an, ar, aok := cutIndex(a)
+		bn, br, bok := cutIndex(b)
+		if aok && bok {
+			if an != bn {
+				return an < bn
+			}
+			a, b = ar, br
+			continue
+		}
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) < len(b)
+}
+
+// cutIndex は s が "[N]" で始まる場合に、添字 N と残りの文字列を返します。
+func cutIndex(s string) (int, string, bool) {
+	if !strings.HasPrefix(s, "[") {
+		return 0, s, false
+	}
+	end := strings.IndexByte(s, ']')
+	if end < 0 {
+		return 0, s, false
+	}
+	n, err := strconv.Atoi(s[1:end])
+	if err != nil {
+		return 0, s, false
+	}
+	return n, s[end+1:], true
+}
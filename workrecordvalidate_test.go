@@ -0,0 +1,86 @@
+package freee
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPutWorkRecordRequestValidateBreakOverlap(t *testing.T) {
+	at := func(hour int, min int) DateTime {
+		return DateTime(time.Date(2024, 4, 1, hour, min, 0, 0, time.UTC))
+	}
+	tests := []struct {
+		name   string
+		breaks [][2]DateTime
+		want   []string // 重複として報告される休憩のパス
+	}{
+		{
+			name:   "no overlap",
+			breaks: [][2]DateTime{{at(12, 0), at(13, 0)}, {at(15, 0), at(15, 15)}},
+			want:   nil,
+		},
+		{
+			name:   "adjacent",
+			breaks: [][2]DateTime{{at(12, 0), at(13, 0)}, {at(13, 0), at(13, 30)}},
+			want:   nil,
+		},
+		{
+			name:   "overlap with previous",
+			breaks: [][2]DateTime{{at(12, 0), at(13, 0)}, {at(12, 30), at(13, 30)}},
+			want:   []string{"break_records[1]"},
+		},
+		{
+			name:   "nested in earlier long break",
+			breaks: [][2]DateTime{{at(12, 0), at(15, 0)}, {at(12, 30), at(13, 0)}, {at(14, 0), at(14, 30)}},
+			want:   []string{"break_records[1]", "break_records[2]"},
+		},
+		{
+			name: "indexes compared numerically",
+			breaks: func() [][2]DateTime {
+				breaks := [][2]DateTime{}
+				for i := 0; i < 9; i++ {
+					breaks = append(breaks, [2]DateTime{at(10, i*5), at(10, i*5+3)})
+				}
+				breaks[2] = [2]DateTime{at(12, 50), at(13, 0)}
+				return append(breaks, [2]DateTime{at(12, 0), at(14, 0)}, [2]DateTime{at(12, 30), at(12, 45)})
+			}(),
+			want: []string{"break_records[2]", "break_records[10]"},
+		},
+		{
+			name:   "unsorted input",
+			breaks: [][2]DateTime{{at(14, 0), at(14, 30)}, {at(12, 0), at(15, 0)}},
+			want:   []string{"break_records[0]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clockInAt, clockOutAt := at(9, 0), at(18, 0)
+			r := &PutWorkRecordRequest{CompanyID: 1, ClockInAt: &clockInAt, ClockOutAt: &clockOutAt}
+			for _, b := range tt.breaks {
+				r.BreakRecords = append(r.BreakRecords, PutWorkRecordBreakRecord{ClockInAt: b[0], ClockOutAt: b[1]})
+			}
+
+			var got []string
+			var verr *WorkRecordValidationError
+			if err := r.Validate(); errors.As(err, &verr) {
+				for _, v := range verr.Violations {
+					if v.Reason == WorkRecordViolationBreakOverlap {
+						got = append(got, v.Field)
+					}
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("overlaps = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("overlaps = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package freee
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-各種申請
+
+type WorkTimeBreakRecord struct {
+	ClockInAt  DateTime `json:"clock_in_at"`
+	ClockOutAt DateTime `json:"clock_out_at"`
+}
+
+type WorkTime struct {
+	ID                  int                       `json:"id"`
+	CompanyID           int                       `json:"company_id"`
+	ApplicationNumber   int                       `json:"application_number"`
+	ApplicantID         int                       `json:"applicant_id"`
+	TargetDate          Date                      `json:"target_date"`
+	ClockInAt           *DateTime                 `json:"clock_in_at"`
+	ClockOutAt          *DateTime                 `json:"clock_out_at"`
+	BreakRecords        []WorkTimeBreakRecord     `json:"break_records"`
+	ClearWorkTime       bool                      `json:"clear_work_time"`
+	Comment             string                    `json:"comment"`
+	Status              ApprovalRequestStatus     `json:"status"`
+	IssueDate           Date                      `json:"issue_date"`
+	ApprovalFlowRouteID int                       `json:"approval_flow_route_id"`
+	CurrentStepID       *int                      `json:"current_step_id"`
+	CurrentRound        int                       `json:"current_round"`
+	Approvers           []ApprovalRequestApprover `json:"approvers"`
+	ApprovalFlowLogs    []ApprovalFlowLog         `json:"approval_flow_logs"`
+}
+
+type ListWorkTimesResult struct {
+	WorkTimes  []WorkTime `json:"work_times"`
+	TotalCount int        `json:"total_count"`
+}
+
+// ListWorkTimes は指定した事業所の勤務時間修正申請のリストと、条件に一致する申請の総数を返します。
+func (c *Client) ListWorkTimes(companyID int, opts *ListApprovalRequestsOpts) (*ListWorkTimesResult, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/work_times"
+	resp, err := c.do(http.MethodGet, u, opts.values(companyID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListWorkTimesResult
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetWorkTime は指定したIDの勤務時間修正申請を返します。
+func (c *Client) GetWorkTime(companyID int, workTimeID int) (WorkTime, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/work_times/" + url.PathEscape(strconv.Itoa(workTimeID))
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return WorkTime{}, err
+	}
+
+	result := struct {
+		WorkTime WorkTime `json:"work_time"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return WorkTime{}, err
+	}
+
+	return result.WorkTime, nil
+}
+
+type WorkTimeRequest struct {
+	CompanyID           int                        `json:"company_id"`
+	TargetDate          Date                       `json:"target_date"`
+	ClockInAt           *DateTime                  `json:"clock_in_at,omitempty"`
+	ClockOutAt          *DateTime                  `json:"clock_out_at,omitempty"`
+	BreakRecords        []PutWorkRecordBreakRecord `json:"break_records,omitempty"`
+	ClearWorkTime       bool                       `json:"clear_work_time,omitempty"` // true を指定すると勤務時間を削除する申請になります
+	Comment             string                     `json:"comment"`                   // 申請理由
+	ApprovalFlowRouteID int                        `json:"approval_flow_route_id"`
+	ApproverID          *int                       `json:"approver_id,omitempty"` // 承認者を申請時に指定する経路の場合のみ指定します
+}
+
+// CreateWorkTime は勤務時間修正申請を新規作成します。
+// 注意点
+// - 日をまたぐ勤務の場合は、退勤時刻に翌日の日時を指定してください。
+func (c *Client) CreateWorkTime(request *WorkTimeRequest) (WorkTime, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/work_times"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return WorkTime{}, err
+	}
+
+	result := struct {
+		WorkTime WorkTime `json:"work_time"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return WorkTime{}, err
+	}
+
+	return result.WorkTime, nil
+}
+
+// ActOnWorkTime は指定した勤務時間修正申請に対して承認・却下などの承認操作を行います。
+// 注意点
+// - 申請が承認されると、申請内容が対象日の勤怠に反映され、GetWorkRecord の結果に含まれるようになります。
+func (c *Client) ActOnWorkTime(workTimeID int, request *ApprovalActionRequest) (WorkTime, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/approval_requests/work_times/" + url.PathEscape(strconv.Itoa(workTimeID)) + "/actions"
+	resp, err := c.do(http.MethodPost, u, nil, request)
+	if err != nil {
+		return WorkTime{}, err
+	}
+
+	result := struct {
+		WorkTime WorkTime `json:"work_time"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return WorkTime{}, err
+	}
+
+	return result.WorkTime, nil
+}
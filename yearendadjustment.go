@@ -0,0 +1,244 @@
+package freee
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// https://developer.freee.co.jp/reference/hr/reference#operations-tag-年末調整
+
+// YearendAdjustmentInsuranceType は年末調整の保険料控除の種類を表します。
+type YearendAdjustmentInsuranceType string
+
+const (
+	YearendAdjustmentInsuranceTypeLife                   YearendAdjustmentInsuranceType = "life"                      // 一般生命保険料
+	YearendAdjustmentInsuranceTypeMedicalCare            YearendAdjustmentInsuranceType = "medical_care"              // 介護医療保険料
+	YearendAdjustmentInsuranceTypeIndividualAnnuity      YearendAdjustmentInsuranceType = "individual_annuity"        // 個人年金保険料
+	YearendAdjustmentInsuranceTypeEarthquake             YearendAdjustmentInsuranceType = "earthquake"                // 地震保険料
+	YearendAdjustmentInsuranceTypeSocial                 YearendAdjustmentInsuranceType = "social"                    // 社会保険料
+	YearendAdjustmentInsuranceTypeSmallBusinessMutualAid YearendAdjustmentInsuranceType = "small_business_mutual_aid" // 小規模企業共済等掛金
+)
+
+type YearendAdjustmentInsurance struct {
+	ID            int                            `json:"id"`
+	InsuranceType YearendAdjustmentInsuranceType `json:"insurance_type"`
+	CompanyName   string                         `json:"company_name"`    // 保険会社等の名称
+	KindOfPurpose string                         `json:"kind_of_purpose"` // 保険等の種類
+	InsuredName   string                         `json:"insured_name"`    // 保険等の契約者の氏名
+	Relationship  DependentRelationship          `json:"relationship"`    // 契約者と本人との続柄
+	NewOrOld      string                         `json:"new_or_old"`      // 新・旧の区分(new, old)
+	Amount        int                            `json:"amount"`          // 本年中に支払った保険料等の金額
+}
+
+type YearendAdjustmentHousingLoan struct {
+	ID                 int    `json:"id"`
+	ResidenceStartDate Date   `json:"residence_start_date"` // 居住開始年月日
+	LoanType           string `json:"loan_type"`            // 住宅借入金等特別控除区分
+	YearEndBalance     int    `json:"year_end_balance"`     // 年末残高
+	DeductibleAmount   int    `json:"deductible_amount"`    // 住宅借入金等特別控除の額
+}
+
+type YearendAdjustmentPreviousJob struct {
+	ID                        int    `json:"id"`
+	CompanyName               string `json:"company_name"`                // 前職の支払者の名称
+	CompanyAddress            string `json:"company_address"`             // 前職の支払者の住所
+	RetireDate                *Date  `json:"retire_date"`                 // 前職の退職年月日
+	Income                    int    `json:"income"`                      // 支払金額
+	WithholdingTax            int    `json:"withholding_tax"`             // 源泉徴収税額
+	SocialInsuranceDeductions int    `json:"social_insurance_deductions"` // 社会保険料等の金額
+}
+
+type YearendAdjustment struct {
+	EmployeeID   int                            `json:"employee_id"`
+	CompanyID    int                            `json:"company_id"`
+	Year         int                            `json:"year"`
+	Status       string                         `json:"status"`
+	Insurances   []YearendAdjustmentInsurance   `json:"insurances"`
+	HousingLoans []YearendAdjustmentHousingLoan `json:"housing_loans"`
+	PreviousJobs []YearendAdjustmentPreviousJob `json:"previous_jobs"`
+	// DependentRules は扶養控除等申告書の申告内容です。更新には UpdateDependentRule を使用します。
+	DependentRules []DependentRule `json:"dependent_rules"`
+}
+
+// InsuranceTotal は指定した種類の保険料の合計金額を返します。
+func (a *YearendAdjustment) InsuranceTotal(insuranceType YearendAdjustmentInsuranceType) int {
+	total := 0
+	for _, i := range a.Insurances {
+		if i.InsuranceType == insuranceType {
+			total += i.Amount
+		}
+	}
+	return total
+}
+
+func yearendAdjustmentURL(year int, employeeID int) string {
+	return "https://api.freee.co.jp/hr/api/v1/yearend_adjustments/" + url.PathEscape(strconv.Itoa(year)) + "/employees/" + url.PathEscape(strconv.Itoa(employeeID))
+}
+
+// GetYearendAdjustment は指定した従業員・年の年末調整の情報を返します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) GetYearendAdjustment(companyID int, employeeID int, year int) (YearendAdjustment, error) {
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	resp, err := c.do(http.MethodGet, yearendAdjustmentURL(year, employeeID), q, nil)
+	if err != nil {
+		return YearendAdjustment{}, err
+	}
+
+	result := struct {
+		YearendAdjustment YearendAdjustment `json:"yearend_adjustment"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return YearendAdjustment{}, err
+	}
+
+	return result.YearendAdjustment, nil
+}
+
+type ListYearendAdjustmentsOpts struct {
+	Limit  int // 取得レコードの件数 (デフォルト: 50, 最小: 1, 最大: 100)
+	Offset int // 取得レコードのオフセット (デフォルト: 0)
+}
+
+type ListYearendAdjustmentsResult struct {
+	YearendAdjustments []YearendAdjustment `json:"yearend_adjustments"`
+	TotalCount         int                 `json:"total_count"`
+}
+
+// ListYearendAdjustments は指定した事業所・年の従業員ごとの年末調整の情報のリストと、従業員の総数を返します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) ListYearendAdjustments(companyID int, year int, opts *ListYearendAdjustmentsOpts) (*ListYearendAdjustmentsResult, error) {
+	u := "https://api.freee.co.jp/hr/api/v1/yearend_adjustments/" + url.PathEscape(strconv.Itoa(year)) + "/employees"
+	q := url.Values{
+		"company_id": {strconv.Itoa(companyID)},
+	}
+	if opts != nil {
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset > 0 {
+			q.Set("offset", strconv.Itoa(opts.Offset))
+		}
+	}
+	resp, err := c.do(http.MethodGet, u, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListYearendAdjustmentsResult
+	if err := resp.Parse(&result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// ListAllYearendAdjustments は ListYearendAdjustments をページングしながら呼び出し、指定した事業所・年の年末調整の情報をすべて返します。
+func (c *Client) ListAllYearendAdjustments(companyID int, year int) ([]YearendAdjustment, error) {
+	opts := &ListYearendAdjustmentsOpts{Limit: 100}
+	all := []YearendAdjustment{}
+	for {
+		result, err := c.ListYearendAdjustments(companyID, year, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, result.YearendAdjustments...)
+		if len(result.YearendAdjustments) == 0 || len(all) >= result.TotalCount {
+			return all, nil
+		}
+		opts.Offset += len(result.YearendAdjustments)
+	}
+}
+
+type UpdateYearendAdjustmentInsuranceRequest struct {
+	CompanyID int                        `json:"company_id"`
+	Insurance YearendAdjustmentInsurance `json:"insurance"`
+}
+
+// UpdateYearendAdjustmentInsurance は指定した従業員・年の保険料控除の申告内容を更新します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) UpdateYearendAdjustmentInsurance(employeeID int, year int, insuranceID int, request *UpdateYearendAdjustmentInsuranceRequest) (YearendAdjustmentInsurance, error) {
+	u := yearendAdjustmentURL(year, employeeID) + "/insurances/" + url.PathEscape(strconv.Itoa(insuranceID))
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return YearendAdjustmentInsurance{}, err
+	}
+
+	result := struct {
+		Insurance YearendAdjustmentInsurance `json:"insurance"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return YearendAdjustmentInsurance{}, err
+	}
+
+	return result.Insurance, nil
+}
+
+type UpdateYearendAdjustmentHousingLoanRequest struct {
+	CompanyID   int                          `json:"company_id"`
+	HousingLoan YearendAdjustmentHousingLoan `json:"housing_loan"`
+}
+
+// UpdateYearendAdjustmentHousingLoan は指定した従業員・年の住宅借入金等特別控除の申告内容を更新します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) UpdateYearendAdjustmentHousingLoan(employeeID int, year int, housingLoanID int, request *UpdateYearendAdjustmentHousingLoanRequest) (YearendAdjustmentHousingLoan, error) {
+	u := yearendAdjustmentURL(year, employeeID) + "/housing_loans/" + url.PathEscape(strconv.Itoa(housingLoanID))
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return YearendAdjustmentHousingLoan{}, err
+	}
+
+	result := struct {
+		HousingLoan YearendAdjustmentHousingLoan `json:"housing_loan"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return YearendAdjustmentHousingLoan{}, err
+	}
+
+	return result.HousingLoan, nil
+}
+
+type UpdateYearendAdjustmentPreviousJobRequest struct {
+	CompanyID   int                          `json:"company_id"`
+	PreviousJob YearendAdjustmentPreviousJob `json:"previous_job"`
+}
+
+// UpdateYearendAdjustmentPreviousJob は指定した従業員・年の前職の源泉徴収票の内容を更新します。
+// 注意点
+// - 管理者権限を持ったユーザーのみ実行可能です。
+func (c *Client) UpdateYearendAdjustmentPreviousJob(employeeID int, year int, previousJobID int, request *UpdateYearendAdjustmentPreviousJobRequest) (YearendAdjustmentPreviousJob, error) {
+	u := yearendAdjustmentURL(year, employeeID) + "/previous_jobs/" + url.PathEscape(strconv.Itoa(previousJobID))
+	resp, err := c.do(http.MethodPut, u, nil, request)
+	if err != nil {
+		return YearendAdjustmentPreviousJob{}, err
+	}
+
+	result := struct {
+		PreviousJob YearendAdjustmentPreviousJob `json:"previous_job"`
+	}{}
+	if err := resp.Parse(&result); err != nil {
+		return YearendAdjustmentPreviousJob{}, err
+	}
+
+	return result.PreviousJob, nil
+}
+
+// GetEmployeeYearendAdjustment は従業員の情報とあわせて、その従業員の year 年の年末調整の情報を返します。
+// 従業員の情報は対象年の12月時点のものです。
+func (c *Client) GetEmployeeYearendAdjustment(companyID int, employeeID int, year int) (Employee, YearendAdjustment, error) {
+	employee, err := c.GetEmployee(companyID, employeeID, year, 12)
+	if err != nil {
+		return Employee{}, YearendAdjustment{}, err
+	}
+	adjustment, err := c.GetYearendAdjustment(companyID, employeeID, year)
+	if err != nil {
+		return Employee{}, YearendAdjustment{}, err
+	}
+	return employee, adjustment, nil
+}